@@ -0,0 +1,88 @@
+package projector
+
+import (
+	"sync"
+	"time"
+)
+
+// asyncReader turns a blocking source of chunks, such as a WebSocket or an
+// SSH pipe, into reads that give up after timeout the way a serial port
+// does.
+type asyncReader struct {
+	chunks  chan []byte
+	err     error
+	pending []byte
+	timeout time.Duration
+	// done is closed by close so the goroutine stops even while no one
+	// reads the chunks it has queued.
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newAsyncReader(read func() ([]byte, error), timeout time.Duration) *asyncReader {
+	r := &asyncReader{chunks: make(chan []byte, 16), timeout: timeout, done: make(chan struct{})}
+	go func() {
+		for {
+			chunk, err := read()
+			if err != nil {
+				r.err = err
+				close(r.chunks)
+				return
+			}
+			if len(chunk) == 0 {
+				continue
+			}
+			select {
+			case r.chunks <- chunk:
+			case <-r.done:
+				return
+			}
+		}
+	}()
+	return r
+}
+
+// close stops the goroutine. The source must be closed too, to end a read
+// in progress.
+func (r *asyncReader) close() {
+	r.closeOnce.Do(func() { close(r.done) })
+}
+
+func (r *asyncReader) Read(b []byte) (int, error) {
+	if len(r.pending) == 0 {
+		timer := time.NewTimer(r.timeout)
+		defer timer.Stop()
+		select {
+		case chunk, ok := <-r.chunks:
+			if !ok {
+				return 0, r.err
+			}
+			r.pending = chunk
+		case <-timer.C:
+			return 0, nil
+		}
+	}
+	n := copy(b, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+// Buffered is non-zero if a chunk has been received and not yet read.
+func (r *asyncReader) Buffered() int {
+	return len(r.pending) + len(r.chunks)
+}
+
+// flush discards everything received so far.
+func (r *asyncReader) flush() {
+	r.pending = nil
+	for {
+		select {
+		case _, ok := <-r.chunks:
+			if !ok {
+				return
+			}
+		default:
+			return
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package projector
+
+import (
+	"github.com/tarm/serial"
+	bugst "go.bug.st/serial"
+)
+
+// SerialBackend opens local serial devices for Open.
+type SerialBackend interface {
+	OpenSerial(name string, config SerialConfig) (Transport, error)
+}
+
+// SerialBackendFunc adapts a function to SerialBackend.
+type SerialBackendFunc func(name string, config SerialConfig) (Transport, error)
+
+func (f SerialBackendFunc) OpenSerial(name string, config SerialConfig) (Transport, error) {
+	return f(name, config)
+}
+
+// ModemControl is implemented by transports that can drive the RS-232
+// control lines. Ports from BugstBackend support it; TarmBackend does not.
+type ModemControl interface {
+	SetRTS(on bool) error
+	SetDTR(on bool) error
+}
+
+// TarmBackend uses github.com/tarm/serial.
+var TarmBackend SerialBackend = SerialBackendFunc(openTarm)
+
+// BugstBackend uses go.bug.st/serial, which also supports RTS/DTR control.
+var BugstBackend SerialBackend = SerialBackendFunc(openBugst)
+
+func openTarm(name string, config SerialConfig) (Transport, error) {
+	return serial.OpenPort(&serial.Config{
+		Name:        name,
+		Baud:        config.Baud,
+		Size:        config.DataBits,
+		Parity:      serial.Parity(config.Parity),
+		StopBits:    serial.StopBits(config.StopBits),
+		ReadTimeout: config.ReadTimeout,
+	})
+}
+
+type bugstPort struct {
+	bugst.Port
+}
+
+func (p bugstPort) Flush() error {
+	return p.ResetInputBuffer()
+}
+
+func openBugst(name string, config SerialConfig) (Transport, error) {
+	mode := &bugst.Mode{BaudRate: config.Baud, DataBits: int(config.DataBits)}
+	switch config.Parity {
+	case PARITY_ODD:
+		mode.Parity = bugst.OddParity
+	case PARITY_EVEN:
+		mode.Parity = bugst.EvenParity
+	case PARITY_MARK:
+		mode.Parity = bugst.MarkParity
+	case PARITY_SPACE:
+		mode.Parity = bugst.SpaceParity
+	default:
+		mode.Parity = bugst.NoParity
+	}
+	switch config.StopBits {
+	case STOP_BITS_1_5:
+		mode.StopBits = bugst.OnePointFiveStopBits
+	case STOP_BITS_2:
+		mode.StopBits = bugst.TwoStopBits
+	default:
+		mode.StopBits = bugst.OneStopBit
+	}
+	port, err := bugst.Open(name, mode)
+	if err != nil {
+		return nil, err
+	}
+	if err = port.SetReadTimeout(config.ReadTimeout); err != nil {
+		port.Close()
+		return nil, err
+	}
+	return bugstPort{port}, nil
+}
+
+// SetRTS drives the RTS line when the transport supports ModemControl.
+func (p *Conn) SetRTS(on bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	control, ok := p.Port.(ModemControl)
+	if !ok {
+		return ProjectorError("Transport does not support modem control")
+	}
+	return control.SetRTS(on)
+}
+
+// SetDTR drives the DTR line when the transport supports ModemControl.
+func (p *Conn) SetDTR(on bool) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	control, ok := p.Port.(ModemControl)
+	if !ok {
+		return ProjectorError("Transport does not support modem control")
+	}
+	return control.SetDTR(on)
+}
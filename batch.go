@@ -0,0 +1,119 @@
+package projector
+
+import "context"
+
+// ErrBatchAborted is reported for batch operations that were not sent
+// because another operation failed first.
+const ErrBatchAborted = ProjectorError("Batch aborted")
+
+// BatchOp is one write in a Batch: the registry command and the value to
+// set it to. Commands without a value ignore Value.
+type BatchOp struct {
+	Command string
+	Value   int
+}
+
+// batchStep is a BatchOp resolved before the batch is queued.
+type batchStep struct {
+	command Command
+	packet  Packet
+	// verify is the read back of the write under WithVerifyWrites, if any.
+	verify   *Command
+	verified Packet
+	expected int
+}
+
+// Batch writes ops in order as a single queued job, so no other command
+// runs between them, e.g. to apply a whole picture preset. Pacing still
+// applies between the writes, and WithCommandTimeout bounds the batch as a
+// whole.
+//
+// Every op is checked against the registry before anything is sent; if one
+// fails, nothing is. Otherwise the batch stops at the first write that
+// fails. The result holds one error per op: nil if it was applied, or
+// ErrBatchAborted if it was not sent because of another op. err is only set
+// if the batch never ran: it is the failing op's error if one was rejected
+// before sending, or ctx's if it ended while the batch was queued.
+func (p *Projector) Batch(ctx context.Context, ops ...BatchOp) (results []error, err error) {
+	results = make([]error, len(ops))
+	steps := make([]batchStep, len(ops))
+	verify := p.verifying()
+	for i, op := range ops {
+		if steps[i], err = p.prepare(op, verify); err != nil {
+			for j := range results {
+				results[j] = ErrBatchAborted
+			}
+			results[i] = withCommand(err, op.Command)
+			return results, results[i]
+		}
+	}
+
+	err = p.submit(ctx, func(ctx context.Context) error {
+		for i, step := range steps {
+			if err := p.runStep(ctx, step); err != nil {
+				abort(results, i, withCommand(err, step.command.Name))
+				return nil
+			}
+		}
+		return nil
+	})
+	return results, err
+}
+
+func (p *Projector) prepare(op BatchOp, verify bool) (batchStep, error) {
+	c, err := p.command(op.Command)
+	if err != nil {
+		return batchStep{}, err
+	}
+	if c.Confirm {
+		return batchStep{}, ErrNotConfirmed
+	}
+	step := batchStep{command: c}
+	op.Value = c.clamp(op.Value)
+	if step.packet, err = c.WritePacket(op.Value); err != nil {
+		return batchStep{}, err
+	}
+	if !verify {
+		return step, nil
+	}
+	name, expected, ok := readBack(c, op.Value)
+	if !ok {
+		return step, nil
+	}
+	rc, err := p.command(name)
+	if err != nil {
+		return batchStep{}, err
+	}
+	if step.verified, err = rc.ReadPacket(); err != nil {
+		return batchStep{}, err
+	}
+	step.verify, step.expected = &rc, expected
+	return step, nil
+}
+
+// runStep writes one prepared op. Callers hold p.mu.
+func (p *Projector) runStep(ctx context.Context, step batchStep) error {
+	if _, err := p.roundTrip(withSpec(ctx, step.command), step.packet); err != nil {
+		return err
+	}
+	if step.verify == nil {
+		return nil
+	}
+	rPacket, err := p.roundTrip(withSpec(ctx, *step.verify), step.verified)
+	if err != nil {
+		return err
+	}
+	actual, err := step.verify.Decode(rPacket)
+	if err != nil {
+		return err
+	}
+	return checkVerify(step.verify.Name, step.expected, actual)
+}
+
+// abort records err for op i and marks the ops after it as aborted.
+func abort(results []error, i int, err error) {
+	results[i] = err
+	for j := i + 1; j < len(results); j++ {
+		results[j] = ErrBatchAborted
+	}
+}
@@ -0,0 +1,58 @@
+package projector_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	projector "github.com/echo1001/go-viewsonic"
+	"github.com/echo1001/go-viewsonic/mocktransport"
+)
+
+func TestBatch(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack(), mocktransport.Ack())
+	p := projector.New(mock)
+	results, err := p.Batch(context.Background(),
+		projector.BatchOp{Command: "volume", Value: 5},
+		projector.BatchOp{Command: "mute", Value: 1},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i, err := range results {
+		if err != nil {
+			t.Errorf("op %d: %v", i, err)
+		}
+	}
+	if n := len(mock.Written()); n != 2 {
+		t.Errorf("wrote %d frames, want 2", n)
+	}
+}
+
+func TestBatchRejected(t *testing.T) {
+	tests := []struct {
+		name string
+		op   projector.BatchOp
+		want error
+	}{
+		{"out of range", projector.BatchOp{Command: "volume", Value: 99}, projector.ErrOutOfRange},
+		{"irreversible", projector.BatchOp{Command: "lamp_hours_reset"}, projector.ErrNotConfirmed},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := mocktransport.New()
+			p := projector.New(mock)
+			results, err := p.Batch(context.Background(), projector.BatchOp{Command: "mute", Value: 1}, tt.op)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			if !errors.Is(results[0], projector.ErrBatchAborted) || !errors.Is(results[1], tt.want) {
+				t.Errorf("results = %v", results)
+			}
+			if n := len(mock.Written()); n != 0 {
+				t.Errorf("wrote %d frames, want none", n)
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package projector
+
+import (
+	"sync"
+	"time"
+)
+
+// Bus arbitrates one physical line, such as an RS-485 run, shared by several
+// projectors with distinct IDs. Handles from Projector take turns on the
+// line one exchange at a time, with a turnaround delay between exchanges so
+// every transceiver has released the line.
+type Bus struct {
+	// Turnaround is the quiet time between exchanges.
+	Turnaround time.Duration
+	// Retries is how many times a device exchange is repeated, unless
+	// overridden with SetRetries. It replaces the handle's RetryPolicy
+	// MaxAttempts, and the turnaround replaces its backoff.
+	Retries int
+
+	port    Transport
+	mu      sync.Mutex
+	last    time.Time
+	retryMu sync.Mutex
+	perID   map[byte]int
+}
+
+func NewBus(port Transport) *Bus {
+	return &Bus{port: port, perID: map[byte]int{}}
+}
+
+// OpenBus opens portName as a shared bus.
+func OpenBus(portName string, opts ...Option) (*Bus, error) {
+	port, err := OpenTransport(portName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return NewBus(port), nil
+}
+
+// Projector returns a handle addressing the unit with the given ID. Closing
+// a handle leaves the bus open.
+func (b *Bus) Projector(id byte) *Projector {
+	p := New(busPort{b.port})
+	p.bus, p.target = b, id
+	return p
+}
+
+// SetRetries overrides Retries for one device, e.g. a unit at the far end
+// of a long run.
+func (b *Bus) SetRetries(id byte, retries int) {
+	b.retryMu.Lock()
+	defer b.retryMu.Unlock()
+	b.perID[id] = retries
+}
+
+func (b *Bus) retries(id byte) int {
+	b.retryMu.Lock()
+	defer b.retryMu.Unlock()
+	if retries, ok := b.perID[id]; ok {
+		return retries
+	}
+	return b.Retries
+}
+
+func (b *Bus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.port.Close()
+}
+
+// acquire takes the line, waiting out the turnaround since the last
+// exchange.
+func (b *Bus) acquire() {
+	b.mu.Lock()
+	if wait := time.Until(b.last.Add(b.Turnaround)); wait > 0 {
+		time.Sleep(wait)
+	}
+}
+
+func (b *Bus) release() {
+	b.last = time.Now()
+	b.mu.Unlock()
+}
+
+// busPort shares the bus transport between handles; only Bus.Close closes
+// it.
+type busPort struct {
+	Transport
+}
+
+func (busPort) Close() error {
+	return nil
+}
+
+func (b busPort) Buffered() int {
+	if t, ok := b.Transport.(bufferedTransport); ok {
+		return t.Buffered()
+	}
+	return 0
+}
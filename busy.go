@@ -0,0 +1,52 @@
+package projector
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrBusy is matched by an *ExceptionError with EXCEPTION_BUSY, which the
+// projector sends for most commands while it is warming up or cooling down.
+const ErrBusy = ProjectorError("Projector busy")
+
+// BusyPolicy makes commands wait out a busy projector instead of failing
+// with ErrBusy.
+type BusyPolicy struct {
+	// Interval is the delay before each retry.
+	Interval time.Duration
+	// MaxWait bounds the total time spent retrying, after which ErrBusy is
+	// returned. Zero retries until the command's context ends.
+	MaxWait time.Duration
+}
+
+// WithBusyRetry repeats commands rejected with ErrBusy under policy. Without
+// it ErrBusy is returned straight away, for callers that run their own wait
+// loop.
+func WithBusyRetry(policy BusyPolicy) Option {
+	return func(p *Projector) {
+		p.busy = &policy
+	}
+}
+
+// exchangeBusy is exchangeWithRetry repeated while the projector reports
+// it is busy. Callers hold p.mu.
+func (p *Conn) exchangeBusy(ctx context.Context, packet Packet) (*Packet, bool, error) {
+	rPacket, sent, err := p.exchangeWithRetry(ctx, packet)
+	if p.busy == nil || p.busy.Interval <= 0 {
+		return rPacket, sent, err
+	}
+	start := time.Now()
+	for errors.Is(err, ErrBusy) {
+		if p.busy.MaxWait > 0 && time.Since(start)+p.busy.Interval > p.busy.MaxWait {
+			break
+		}
+		if serr := sleepContext(ctx, p.busy.Interval); serr != nil {
+			return nil, sent, serr
+		}
+		var retrySent bool
+		rPacket, retrySent, err = p.exchangeWithRetry(ctx, packet)
+		sent = sent || retrySent
+	}
+	return rPacket, sent, err
+}
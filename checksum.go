@@ -0,0 +1,32 @@
+package projector
+
+// Checksum computes the trailing byte of the frame that carries p.
+type Checksum func(p *Packet) byte
+
+// ChecksumStandard is the documented checksum, Packet.Checksum: 0x14 plus
+// the ID, length and data bytes.
+func ChecksumStandard(p *Packet) byte {
+	return p.Checksum()
+}
+
+// ChecksumWithCommand also counts the command byte, as a few firmware
+// revisions do.
+func ChecksumWithCommand(p *Packet) byte {
+	return byte(p.Command) + p.Checksum()
+}
+
+// WithChecksum selects how the trailing byte of frames sent and received is
+// computed. The default is ChecksumStandard, or the profile's Checksum with
+// WithModel; whichever option comes last wins.
+func WithChecksum(c Checksum) Option {
+	return func(p *Projector) {
+		p.checksum = c
+	}
+}
+
+func (c Checksum) of(p *Packet) byte {
+	if c == nil {
+		return p.Checksum()
+	}
+	return c(p)
+}
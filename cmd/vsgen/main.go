@@ -0,0 +1,541 @@
+// Command vsgen generates the projector package's command table and typed
+// wrapper methods from a description of a ViewSonic RS-232 function table.
+//
+//	vsgen -in commands.csv -out commands_gen.go [-tests commands_gen_test.go]
+//
+// The input is CSV with a header row, or JSON (by .json extension) holding
+// an array of objects with the same fields:
+//
+//	name        registry name, e.g. lamp_hours
+//	method      Go method name; read/write commands also get Set<method>.
+//	            A lower-case name gets only unexported Context wrappers,
+//	            for commands wrapped by hand, and no tests
+//	opcode      two function bytes in hex, e.g. 1501
+//	access      r, w or rw
+//	type        none, bool, uint8, int8, uint16, int16 or uint32
+//	gotype      enum declared in the package that the wrappers use instead
+//	            of type's own; values missing from its <gotype>Names table
+//	            are rejected. Optional
+//	min, max    documented value range, optional
+//	clamp       y to set the nearest bound for values outside the range
+//	            instead of failing; optional
+//	verify      for write-only commands, the command that reads back the
+//	            effect, optionally with the value it should read, e.g.
+//	            power=1; optional
+//	timeout     response timeout overriding the client's, e.g. 10s; optional
+//	flags       space-separated markers, optional: power for power
+//	            transitions, which Pacing.PowerDelay holds off after;
+//	            confirm for irreversible commands, which need a lower-case
+//	            method and type none and whose wrapper takes a force flag;
+//	            idempotent for absolute setters that are safe to resend
+//	            when the reply is lost
+//	doc         doc comment for the method, optional
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+)
+
+type command struct {
+	Name     string `json:"name"`
+	Method   string `json:"method"`
+	Opcode   string `json:"opcode"`
+	Access   string `json:"access"`
+	Type     string `json:"type"`
+	TypeName string `json:"gotype"`
+	Min      string `json:"min"`
+	Max      string `json:"max"`
+	Clamp    string `json:"clamp"`
+	Verify   string `json:"verify"`
+	Timeout  string `json:"timeout"`
+	Flags    string `json:"flags"`
+	Doc      string `json:"doc"`
+}
+
+var goTypes = map[string]string{
+	"none":   "",
+	"bool":   "bool",
+	"uint8":  "uint8",
+	"int8":   "int8",
+	"uint16": "uint16",
+	"int16":  "int16",
+	"uint32": "uint32",
+}
+
+// knownFlags are the markers the flags column accepts. Each sets the
+// Command field of the same name.
+var knownFlags = map[string]bool{
+	"power":      true,
+	"confirm":    true,
+	"idempotent": true,
+}
+
+func main() {
+	in := flag.String("in", "commands.csv", "command table to read")
+	out := flag.String("out", "commands_gen.go", "Go file to write")
+	tests := flag.String("tests", "", "also write tests for the wrappers to this file")
+	pkg := flag.String("package", "projector", "package name of the generated code")
+	flag.Parse()
+
+	commands, err := load(*in)
+	if err != nil {
+		log.Fatal(err)
+	}
+	timeouts := false
+	for _, c := range commands {
+		timeouts = timeouts || c.Timeout != ""
+	}
+	data := map[string]any{"Source": filepath.Base(*in), "Package": *pkg, "Commands": commands, "Timeouts": timeouts}
+	if err = generate(*out, sourceTemplate, data); err != nil {
+		log.Fatal(err)
+	}
+	if *tests != "" {
+		if err = generate(*tests, testTemplate, data); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func load(path string) ([]command, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var commands []command
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.NewDecoder(f).Decode(&commands)
+	} else {
+		commands, err = loadCSV(f)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for i, c := range commands {
+		if err = c.validate(); err != nil {
+			return nil, fmt.Errorf("%s: %s: %w", path, c.Name, err)
+		}
+		commands[i].Access = strings.ToLower(c.Access)
+		if c.Type == "" {
+			commands[i].Type = "none"
+		}
+	}
+	return commands, nil
+}
+
+func loadCSV(r io.Reader) ([]command, error) {
+	cr := csv.NewReader(r)
+	cr.Comment = '#'
+	rows, err := cr.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	field := func(row []string, name string) string {
+		if i, ok := columns[name]; ok && i < len(row) {
+			return strings.TrimSpace(row[i])
+		}
+		return ""
+	}
+	var commands []command
+	for _, row := range rows[1:] {
+		commands = append(commands, command{
+			Name:     field(row, "name"),
+			Method:   field(row, "method"),
+			Opcode:   field(row, "opcode"),
+			Access:   field(row, "access"),
+			Type:     field(row, "type"),
+			TypeName: field(row, "gotype"),
+			Min:      field(row, "min"),
+			Max:      field(row, "max"),
+			Verify:   field(row, "verify"),
+			Clamp:    field(row, "clamp"),
+			Timeout:  field(row, "timeout"),
+			Flags:    field(row, "flags"),
+			Doc:      field(row, "doc"),
+		})
+	}
+	return commands, nil
+}
+
+func (c command) validate() error {
+	if c.Name == "" || c.Method == "" {
+		return fmt.Errorf("name and method are required")
+	}
+	if _, err := strconv.ParseUint(c.Opcode, 16, 16); err != nil || len(c.Opcode) != 4 {
+		return fmt.Errorf("opcode %q is not two hex bytes", c.Opcode)
+	}
+	switch strings.ToLower(c.Access) {
+	case "r", "w", "rw":
+	default:
+		return fmt.Errorf("access %q is not r, w or rw", c.Access)
+	}
+	if _, ok := goTypes[c.Type]; !ok && c.Type != "" {
+		return fmt.Errorf("unknown type %q", c.Type)
+	}
+	if c.TypeName != "" && (c.Type == "" || c.Type == "none" || c.Type == "bool") {
+		return fmt.Errorf("gotype needs a numeric type")
+	}
+	if name, value, ok := strings.Cut(c.Verify, "="); ok {
+		if _, err := strconv.Atoi(value); err != nil || name == "" {
+			return fmt.Errorf("verify %q is not name or name=value", c.Verify)
+		}
+	}
+	if _, err := time.ParseDuration(c.Timeout); err != nil && c.Timeout != "" {
+		return fmt.Errorf("timeout %q is not a duration", c.Timeout)
+	}
+	for _, flag := range strings.Fields(c.Flags) {
+		if !knownFlags[flag] {
+			return fmt.Errorf("unknown flag %q", flag)
+		}
+	}
+	if c.Confirm() && (token.IsExported(c.Method) || c.Type != "" && c.Type != "none") {
+		return fmt.Errorf("confirm needs a lower-case method and type none")
+	}
+	switch strings.ToLower(c.Clamp) {
+	case "", "y":
+	default:
+		return fmt.Errorf("clamp %q is not y or empty", c.Clamp)
+	}
+	if c.Clamp != "" && c.Min == "" && c.Max == "" {
+		return fmt.Errorf("clamp needs a range")
+	}
+	for _, bound := range []string{c.Min, c.Max} {
+		if _, err := strconv.Atoi(bound); err != nil && bound != "" {
+			return fmt.Errorf("range bound %q is not an integer", bound)
+		}
+	}
+	return nil
+}
+
+func (c command) Readable() bool { return strings.Contains(c.Access, "r") }
+func (c command) Writable() bool { return strings.Contains(c.Access, "w") }
+func (c command) GoType() string {
+	if c.TypeName != "" {
+		return c.TypeName
+	}
+	return goTypes[c.Type]
+}
+
+func (c command) Exported() bool { return token.IsExported(c.Method) }
+
+func (c command) Confirm() bool {
+	for _, flag := range strings.Fields(c.Flags) {
+		if flag == "confirm" {
+			return true
+		}
+	}
+	return false
+}
+
+func (c command) Setter() string {
+	switch {
+	case !c.Readable():
+		return c.Method
+	case c.Exported():
+		return "Set" + c.Method
+	}
+	return "set" + strings.ToUpper(c.Method[:1]) + c.Method[1:]
+}
+
+func (c command) AccessConst() string {
+	switch c.Access {
+	case "r":
+		return "ACCESS_READ"
+	case "w":
+		return "ACCESS_WRITE"
+	}
+	return "ACCESS_READ | ACCESS_WRITE"
+}
+
+func (c command) TypeConst() string {
+	return "VALUE_" + strings.ToUpper(c.Type)
+}
+
+func (c command) Range() string {
+	if c.Min == "" && c.Max == "" {
+		return ""
+	}
+	r := fmt.Sprintf(", Min: %s, Max: %s", orZero(c.Min), orZero(c.Max))
+	if c.Clamp != "" {
+		r += ", Clamp: true"
+	}
+	return r
+}
+
+// ValuesField restricts an enum command to the values its names table
+// defines, e.g. sourceNames for Source.
+func (c command) ValuesField() string {
+	if c.TypeName == "" {
+		return ""
+	}
+	name := []rune(c.TypeName)
+	i := 0
+	for i < len(name) && unicode.IsUpper(name[i]) && (i == 0 || i+1 >= len(name) || !unicode.IsLower(name[i+1])) {
+		name[i] = unicode.ToLower(name[i])
+		i++
+	}
+	return fmt.Sprintf(", Values: enumValues(%sNames)", string(name))
+}
+
+func (c command) VerifyFields() string {
+	if c.Verify == "" {
+		return ""
+	}
+	name, value, ok := strings.Cut(c.Verify, "=")
+	if !ok {
+		return fmt.Sprintf(", Verify: %q", name)
+	}
+	return fmt.Sprintf(", Verify: %q, VerifyValue: %s", name, value)
+}
+
+func (c command) FlagFields() string {
+	var fields string
+	for _, flag := range strings.Fields(c.Flags) {
+		fields += fmt.Sprintf(", %s: true", strings.ToUpper(flag[:1])+flag[1:])
+	}
+	return fields
+}
+
+func (c command) TimeoutField() string {
+	if c.Timeout == "" {
+		return ""
+	}
+	d, _ := time.ParseDuration(c.Timeout)
+	if d%time.Second == 0 {
+		return fmt.Sprintf(", Timeout: %d * time.Second", d/time.Second)
+	}
+	return fmt.Sprintf(", Timeout: %d * time.Millisecond", d.Milliseconds())
+}
+
+// Sample is a value in range used by the generated tests; the negative
+// bound for signed types, to exercise their encoding.
+func (c command) Sample() string {
+	if c.Type == "bool" {
+		return "true"
+	}
+	if strings.HasPrefix(c.Type, "int") && strings.HasPrefix(c.Min, "-") {
+		return c.Min
+	}
+	if c.Max != "" {
+		return c.Max
+	}
+	return "1"
+}
+
+// SampleBytes is Sample as it goes on the wire, for the generated tests.
+func (c command) SampleBytes() string {
+	return c.wire(c.Sample())
+}
+
+// ReadSample is the value the generated tests have the projector report:
+// Sample, or for uint32 one that fills every byte.
+func (c command) ReadSample() string {
+	if c.Type == "uint32" && c.Max == "" {
+		return "16909060"
+	}
+	return c.Sample()
+}
+
+// ReadSampleBytes is ReadSample as it comes off the wire.
+func (c command) ReadSampleBytes() string {
+	return c.wire(c.ReadSample())
+}
+
+// wire returns the little-endian bytes of sample as Go literals.
+func (c command) wire(sample string) string {
+	v := 1
+	if c.Type != "bool" {
+		v, _ = strconv.Atoi(sample)
+	}
+	size := map[string]int{"uint16": 2, "int16": 2, "uint32": 4}[c.Type]
+	if size == 0 {
+		size = 1
+	}
+	b := make([]string, size)
+	for i := range b {
+		b[i] = fmt.Sprintf("0x%02x", byte(v>>(8*i)))
+	}
+	return strings.Join(b, ", ")
+}
+
+func orZero(s string) string {
+	if s == "" {
+		return "0"
+	}
+	return s
+}
+
+func generate(path, text string, data any) error {
+	tmpl := template.Must(template.New(path).Parse(text))
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return fmt.Errorf("%s: %w\n%s", path, err, buf.Bytes())
+	}
+	return os.WriteFile(path, src, 0644)
+}
+
+const sourceTemplate = `// Code generated by vsgen from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+	"context"
+{{- if .Timeouts}}
+	"time"
+{{- end}}
+)
+
+var commandTable = []Command{
+{{- range .Commands}}
+	{Name: "{{.Name}}", Opcode: 0x{{.Opcode}}, Access: {{.AccessConst}}, Type: {{.TypeConst}}{{.Range}}{{.ValuesField}}{{.VerifyFields}}{{.TimeoutField}}{{.FlagFields}}},
+{{- end}}
+}
+{{range .Commands}}
+{{- if .Readable}}
+{{if .Doc}}// {{.Method}} {{.Doc}}
+{{end -}}
+{{if .Exported -}}
+func (p *Projector) {{.Method}}() ({{.GoType}}, error) {
+	return p.{{.Method}}Context(context.Background())
+}
+
+{{end -}}
+func (p *Projector) {{.Method}}Context(ctx context.Context) ({{.GoType}}, error) {
+	v, err := p.get(ctx, "{{.Name}}")
+{{- if eq .Type "bool"}}
+	return v != 0, err
+{{- else}}
+	return {{.GoType}}(v), err
+{{- end}}
+}
+{{end}}
+{{- if .Writable}}
+{{if and .Doc (not .Readable)}}// {{.Method}} {{.Doc}}
+{{end -}}
+{{if .Confirm -}}
+func (p *Projector) {{.Setter}}Context(ctx context.Context, force bool) error {
+	return p.setForced(ctx, "{{.Name}}", 0, force)
+}
+{{- else if eq .Type "none" -}}
+{{if .Exported -}}
+func (p *Projector) {{.Setter}}() error {
+	return p.{{.Setter}}Context(context.Background())
+}
+
+{{end -}}
+func (p *Projector) {{.Setter}}Context(ctx context.Context) error {
+	return p.set(ctx, "{{.Name}}", 0)
+}
+{{- else -}}
+{{if .Exported -}}
+func (p *Projector) {{.Setter}}(v {{.GoType}}) error {
+	return p.{{.Setter}}Context(context.Background(), v)
+}
+
+{{end -}}
+func (p *Projector) {{.Setter}}Context(ctx context.Context, v {{.GoType}}) error {
+{{- if eq .Type "bool"}}
+	return p.set(ctx, "{{.Name}}", boolValue(v))
+{{- else}}
+	return p.set(ctx, "{{.Name}}", int(v))
+{{- end}}
+}
+{{- end}}
+{{end}}
+{{- end}}
+`
+
+const testTemplate = `// Code generated by vsgen from {{.Source}}; DO NOT EDIT.
+
+package {{.Package}}_test
+
+import (
+	"bytes"
+	"testing"
+
+	projector "github.com/echo1001/go-viewsonic"
+	"github.com/echo1001/go-viewsonic/mocktransport"
+)
+
+func expectFrame(t *testing.T, mock *mocktransport.Transport, name string, command projector.CommandType, data ...byte) {
+	t.Helper()
+	want := projector.Packet{Command: command, Data: data}
+	if got := bytes.Join(mock.Written(), nil); !bytes.Equal(got, want.Build()) {
+		t.Errorf("%s wrote % x, want % x", name, got, want.Build())
+	}
+}
+
+// newProjector opens mock under the profile the named command is exclusive
+// to, if any.
+func newProjector(mock *mocktransport.Transport, name string) *projector.Projector {
+	for _, m := range projector.ModelProfiles {
+		for _, exclusive := range m.Exclusive {
+			if exclusive == name {
+				return projector.New(mock, projector.WithModel(m))
+			}
+		}
+	}
+	return projector.New(mock)
+}
+{{range .Commands}}
+{{- if and .Exported .Readable}}
+func Test{{.Method}}(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response({{.ReadSampleBytes}}))
+	p := newProjector(mock, "{{.Name}}")
+	v, err := p.{{.Method}}()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != {{.ReadSample}} {
+		t.Errorf("{{.Method}} = %v, want {{.ReadSample}}", v)
+	}
+	expectFrame(t, mock, "{{.Method}}", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x{{slice .Opcode 0 2}}, 0x{{slice .Opcode 2 4}})
+}
+{{end}}
+{{- if and .Exported .Writable}}
+func Test{{.Setter}}(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "{{.Name}}")
+{{- if eq .Type "none"}}
+	if err := p.{{.Setter}}(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "{{.Setter}}", projector.COMMAND_WRITE, 0x34, 0x{{slice .Opcode 0 2}}, 0x{{slice .Opcode 2 4}}, 0x00)
+{{- else}}
+	if err := p.{{.Setter}}({{.Sample}}); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "{{.Setter}}", projector.COMMAND_WRITE, 0x34, 0x{{slice .Opcode 0 2}}, 0x{{slice .Opcode 2 4}}, {{.SampleBytes}})
+{{- end}}
+}
+{{end}}
+{{- end}}`
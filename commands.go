@@ -0,0 +1,379 @@
+package projector
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+type CommandAccess byte
+
+const ACCESS_READ CommandAccess = 1
+const ACCESS_WRITE CommandAccess = 2
+
+// ValueType is how a command's value is encoded on the wire.
+type ValueType byte
+
+const VALUE_NONE ValueType = 0
+const VALUE_BOOL ValueType = 1
+const VALUE_UINT8 ValueType = 2
+const VALUE_INT8 ValueType = 3
+const VALUE_UINT16 ValueType = 4
+const VALUE_INT16 ValueType = 5
+const VALUE_UINT32 ValueType = 6
+
+// Command describes one function from the ViewSonic RS-232 command table.
+// Reads send 34 00 00 <opcode>; writes send 34 <opcode> <value>.
+type Command struct {
+	Name   string
+	Opcode uint16
+	Access CommandAccess
+	Type   ValueType
+	// Min and Max are the documented value range; both zero means the
+	// table gives none. Values outside it are rejected, or with Clamp set
+	// to the nearest bound.
+	Min, Max int
+	Clamp    bool
+	// Values, if set, are the only values accepted, for enums whose
+	// defined values have gaps.
+	Values []int
+	// Verify names the command that reads back the effect of a write-only
+	// command for WithVerifyWrites. VerifyValue is what it should read;
+	// commands that take a value expect that value instead.
+	Verify      string
+	VerifyValue int
+	// Timeout, if set, replaces the response timeout for this command, for
+	// operations such as power transitions that are slow to acknowledge.
+	Timeout time.Duration
+	// Power marks power transitions, which Pacing.PowerDelay holds off
+	// after.
+	Power bool
+	// Confirm marks irreversible commands. Exec and Batch refuse them with
+	// ErrNotConfirmed; only their wrappers, given a force flag, send them.
+	Confirm bool
+	// Idempotent marks writes that leave the same state however often they
+	// are applied, which the retry policy may resend after a lost reply.
+	Idempotent bool
+}
+
+// Command Table Ref pg. 66: https://www.viewsoniceurope.com/asset-files/files/user_guide/pjd7820hd/28077.pdf
+//
+// commandTable, the typed wrappers and their tests are generated from
+// commands.csv.
+
+//go:generate go run ./cmd/vsgen -in commands.csv -out commands_gen.go -tests commands_gen_test.go
+
+// registryMu guards commandTable and commandIndex against RegisterCommand.
+var registryMu sync.RWMutex
+
+var commandIndex = map[string]int{}
+
+func init() {
+	for i, c := range commandTable {
+		commandIndex[c.Name] = i
+	}
+}
+
+// RegisterCommand adds spec to the registry as name, for opcodes the
+// generated table lacks such as model-specific or undocumented functions.
+// Registered commands run through Exec with the same framing, retries,
+// verification and tracing as the built-in ones. A name already registered
+// is rejected.
+func RegisterCommand(name string, spec Command) error {
+	if name == "" {
+		return ProjectorError("Command name is empty")
+	}
+	if spec.Access&(ACCESS_READ|ACCESS_WRITE) == 0 {
+		return ProjectorError("Command is neither readable nor writable")
+	}
+	spec.Name = name
+	registryMu.Lock()
+	defer registryMu.Unlock()
+	if _, ok := commandIndex[name]; ok {
+		return ProjectorError("Command already registered")
+	}
+	commandIndex[name] = len(commandTable)
+	commandTable = append(commandTable, spec)
+	return nil
+}
+
+// LookupCommand returns the registered command called name.
+func LookupCommand(name string) (Command, bool) {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	i, ok := commandIndex[name]
+	if !ok {
+		return Command{}, false
+	}
+	return commandTable[i], true
+}
+
+// Commands returns every registered command in table order.
+func Commands() []Command {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	return append([]Command(nil), commandTable...)
+}
+
+func (c Command) String() string {
+	return c.Name
+}
+
+// ReadPacket returns the packet that queries c.
+func (c Command) ReadPacket() (Packet, error) {
+	if c.Access&ACCESS_READ == 0 {
+		return Packet{}, ProjectorError("Command is not readable")
+	}
+	return Packet{Command: COMMAND_READ, Data: []byte{0x34, 0x00, 0x00, byte(c.Opcode >> 8), byte(c.Opcode)}}, nil
+}
+
+// WritePacket returns the packet that sets c to value. Commands without a
+// value ignore it; others fail with a *RangeError outside Min..Max.
+func (c Command) WritePacket(value int) (Packet, error) {
+	if c.Access&ACCESS_WRITE == 0 {
+		return Packet{}, ProjectorError("Command is not writable")
+	}
+	if err := c.CheckRange(value); err != nil {
+		return Packet{}, err
+	}
+	b, err := c.Encode(value)
+	if err != nil {
+		return Packet{}, err
+	}
+	return Packet{Command: COMMAND_WRITE, Data: append([]byte{0x34, byte(c.Opcode >> 8), byte(c.Opcode)}, b...)}, nil
+}
+
+// Encode returns the value bytes that set c to value: one byte for the
+// 8-bit types, little-endian for wider ones, and signed types in two's
+// complement. Commands without a value send a single zero byte.
+func (c Command) Encode(value int) ([]byte, error) {
+	switch c.Type {
+	case VALUE_NONE:
+		return []byte{0}, nil
+	case VALUE_BOOL:
+		return EncodeBool(value != 0), nil
+	case VALUE_UINT8:
+		if value >= 0 && value <= math.MaxUint8 {
+			return EncodeUint8(uint8(value)), nil
+		}
+	case VALUE_INT8:
+		if value >= math.MinInt8 && value <= math.MaxInt8 {
+			return EncodeInt8(int8(value)), nil
+		}
+	case VALUE_UINT16:
+		if value >= 0 && value <= math.MaxUint16 {
+			return EncodeUint16(uint16(value)), nil
+		}
+	case VALUE_INT16:
+		if value >= math.MinInt16 && value <= math.MaxInt16 {
+			return EncodeInt16(int16(value)), nil
+		}
+	case VALUE_UINT32:
+		if value >= 0 && uint64(value) <= math.MaxUint32 {
+			return EncodeUint32(uint32(value)), nil
+		}
+	default:
+		return nil, ProjectorError("Value type cannot be written")
+	}
+	return nil, ProjectorError("Value does not fit command type")
+}
+
+// CheckRange reports whether value is within c's documented range and, for
+// enums, one of its Values. Commands without a value or a range accept
+// anything.
+func (c Command) CheckRange(value int) error {
+	if c.Type == VALUE_NONE {
+		return nil
+	}
+	if c.Values != nil && !c.defines(value) {
+		return &RangeError{Command: c.Name, Value: value, Min: c.Min, Max: c.Max, Values: c.Values}
+	}
+	if c.Min == 0 && c.Max == 0 {
+		return nil
+	}
+	if value < c.Min || value > c.Max {
+		return &RangeError{Command: c.Name, Value: value, Min: c.Min, Max: c.Max}
+	}
+	return nil
+}
+
+func (c Command) defines(value int) bool {
+	for _, v := range c.Values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// clamp applies Clamp to value.
+func (c Command) clamp(value int) int {
+	if !c.Clamp || c.Min == 0 && c.Max == 0 {
+		return value
+	}
+	if value < c.Min {
+		return c.Min
+	}
+	if value > c.Max {
+		return c.Max
+	}
+	return value
+}
+
+// Decode extracts c's value from a response.
+func (c Command) Decode(packet *Packet) (int, error) {
+	value := packet.Value()
+	switch c.Type {
+	case VALUE_BOOL:
+		v, err := DecodeBool(value)
+		if v {
+			return 1, err
+		}
+		return 0, err
+	case VALUE_UINT8:
+		v, err := DecodeUint8(value)
+		return int(v), err
+	case VALUE_INT8:
+		v, err := DecodeInt8(value)
+		return int(v), err
+	case VALUE_UINT16:
+		v, err := DecodeUint16(value)
+		return int(v), err
+	case VALUE_INT16:
+		v, err := DecodeInt16(value)
+		return int(v), err
+	case VALUE_UINT32:
+		v, err := DecodeUint32(value)
+		return int(v), err
+	}
+	return 0, nil
+}
+
+func boolValue(v bool) int {
+	if v {
+		return 1
+	}
+	return 0
+}
+
+func lookup(name string) (Command, error) {
+	c, ok := LookupCommand(name)
+	if !ok {
+		return Command{}, ProjectorError("Unknown command")
+	}
+	return c, nil
+}
+
+// command resolves name against the projector's model and what Probe
+// found.
+func (p *Projector) command(name string) (Command, error) {
+	p.mu.Lock()
+	model, caps := p.model, p.caps
+	p.mu.Unlock()
+	if caps != nil {
+		if answered, probed := caps.Commands[name]; probed && !answered {
+			return Command{}, ErrUnsupported
+		}
+	}
+	return model.Command(name)
+}
+
+type commandKey struct{}
+
+// withSpec sends packets run with the returned context as c, under its
+// response timeout, pacing and retry rules.
+func withSpec(ctx context.Context, c Command) context.Context {
+	return context.WithValue(withResponseTimeout(ctx, c.Timeout), commandKey{}, c)
+}
+
+// specFor returns the registry command packet was built from: the one
+// given to withSpec, or for a raw write the registry write with its opcode.
+func specFor(ctx context.Context, packet Packet) (Command, bool) {
+	if c, ok := ctx.Value(commandKey{}).(Command); ok {
+		return c, true
+	}
+	if packet.Command != COMMAND_WRITE || len(packet.Data) < 3 || packet.Data[0] != 0x34 {
+		return Command{}, false
+	}
+	opcode := uint16(packet.Data[1])<<8 | uint16(packet.Data[2])
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+	for _, c := range commandTable {
+		if c.Opcode == opcode && c.Access&ACCESS_WRITE != 0 {
+			return c, true
+		}
+	}
+	return Command{}, false
+}
+
+// get reads the value of the named command.
+func (p *Projector) get(ctx context.Context, name string) (int, error) {
+	c, err := p.command(name)
+	if err != nil {
+		return 0, withCommand(err, name)
+	}
+	packet, err := c.ReadPacket()
+	if err != nil {
+		return 0, withCommand(err, name)
+	}
+	rPacket, err := p.WriteAndReadContext(withSpec(ctx, c), packet)
+	if err != nil {
+		return 0, withCommand(err, name)
+	}
+	v, err := c.Decode(rPacket)
+	return v, withCommand(err, name)
+}
+
+// set writes value to the named command.
+func (p *Projector) set(ctx context.Context, name string, value int) error {
+	return p.setForced(ctx, name, value, false)
+}
+
+// setForced is set, also sending a command marked Confirm if force is set.
+func (p *Projector) setForced(ctx context.Context, name string, value int, force bool) error {
+	c, err := p.command(name)
+	if err != nil {
+		return withCommand(err, name)
+	}
+	if c.Confirm && !force {
+		return withCommand(ErrNotConfirmed, name)
+	}
+	value = c.clamp(value)
+	packet, err := c.WritePacket(value)
+	if err != nil {
+		return withCommand(err, name)
+	}
+	if _, err = p.WriteAndReadContext(withSpec(ctx, c), packet); err != nil {
+		return withCommand(err, name)
+	}
+	if p.verifying() {
+		return withCommand(p.verify(ctx, c, value), name)
+	}
+	return nil
+}
+
+// Exec runs the named command. With no args a readable command is read and
+// its value returned; otherwise the command is written, with args[0] as the
+// value for commands that take one, and 0 is returned.
+func (p *Projector) Exec(name string, args ...int) (int, error) {
+	return p.ExecContext(context.Background(), name, args...)
+}
+
+func (p *Projector) ExecContext(ctx context.Context, name string, args ...int) (int, error) {
+	c, err := p.command(name)
+	if err != nil {
+		return 0, withCommand(err, name)
+	}
+	if len(args) > 1 {
+		return 0, withCommand(ProjectorError("Too many arguments"), name)
+	}
+	if len(args) == 0 && c.Access&ACCESS_READ != 0 {
+		return p.get(ctx, name)
+	}
+	if len(args) == 0 && c.Type != VALUE_NONE {
+		return 0, withCommand(ProjectorError("Missing value"), name)
+	}
+	args = append(args, 0)
+	return 0, p.set(ctx, name, args[0])
+}
@@ -0,0 +1,1058 @@
+// Code generated by vsgen from commands.csv; DO NOT EDIT.
+
+package projector
+
+import (
+	"context"
+	"time"
+)
+
+var commandTable = []Command{
+	{Name: "power", Opcode: 0x1100, Access: ACCESS_READ, Type: VALUE_BOOL},
+	{Name: "power_on", Opcode: 0x1100, Access: ACCESS_WRITE, Type: VALUE_NONE, Verify: "power", VerifyValue: 1, Timeout: 10 * time.Second, Power: true},
+	{Name: "power_off", Opcode: 0x1101, Access: ACCESS_WRITE, Type: VALUE_NONE, Verify: "power", VerifyValue: 0, Timeout: 10 * time.Second, Power: true},
+	{Name: "lamp_hours", Opcode: 0x1501, Access: ACCESS_READ, Type: VALUE_UINT32},
+	{Name: "source", Opcode: 0x1301, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 15, Values: enumValues(sourceNames), Idempotent: true},
+	{Name: "quick_auto_search", Opcode: 0x1302, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "volume", Opcode: 0x1403, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 20, Idempotent: true},
+	{Name: "volume_up", Opcode: 0x1401, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "volume_down", Opcode: 0x1402, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "mute", Opcode: 0x1400, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "mic_volume", Opcode: 0x1404, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 20, Idempotent: true},
+	{Name: "treble", Opcode: 0x1405, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -10, Max: 10, Idempotent: true},
+	{Name: "bass", Opcode: 0x1406, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -10, Max: 10, Idempotent: true},
+	{Name: "audio_source", Opcode: 0x1407, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 2, Values: enumValues(audioSourceNames), Idempotent: true},
+	{Name: "blank", Opcode: 0x1209, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "freeze", Opcode: 0x1300, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "keystone_v", Opcode: 0x120A, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -40, Max: 40, Idempotent: true},
+	{Name: "keystone_v_up", Opcode: 0x1228, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "keystone_v_down", Opcode: 0x1229, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "keystone_h", Opcode: 0x120C, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -40, Max: 40, Idempotent: true},
+	{Name: "auto_keystone", Opcode: 0x120D, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "brightness", Opcode: 0x1203, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Clamp: true, Idempotent: true},
+	{Name: "contrast", Opcode: 0x1202, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "sharpness", Opcode: 0x120E, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 15, Idempotent: true},
+	{Name: "color_temperature", Opcode: 0x1208, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 3, Values: enumValues(colorTemperatureNames), Idempotent: true},
+	{Name: "red_gain", Opcode: 0x1220, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "green_gain", Opcode: 0x1221, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "blue_gain", Opcode: 0x1222, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "red_offset", Opcode: 0x1223, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -50, Max: 50, Idempotent: true},
+	{Name: "green_offset", Opcode: 0x1224, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -50, Max: 50, Idempotent: true},
+	{Name: "blue_offset", Opcode: 0x1225, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -50, Max: 50, Idempotent: true},
+	{Name: "aspect_ratio", Opcode: 0x1204, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 6, Values: enumValues(aspectRatioNames), Idempotent: true},
+	{Name: "color_mode", Opcode: 0x120B, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 5, Values: enumValues(colorModeNames), Idempotent: true},
+	{Name: "gamma", Opcode: 0x120F, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 4, Values: enumValues(gammaNames), Idempotent: true},
+	{Name: "hue", Opcode: 0x1210, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT8, Min: -50, Max: 50, Idempotent: true},
+	{Name: "saturation", Opcode: 0x1211, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "color_gain", Opcode: 0x1212, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "brilliant_color", Opcode: 0x1213, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 10, Idempotent: true},
+	{Name: "overscan", Opcode: 0x1214, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 10, Idempotent: true},
+	{Name: "noise_reduction", Opcode: 0x1215, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 10, Idempotent: true},
+	{Name: "film_mode", Opcode: 0x1216, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "hdmi_range", Opcode: 0x1217, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 2, Values: enumValues(hdmiRangeNames), Idempotent: true},
+	{Name: "hdmi_format", Opcode: 0x1218, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 2, Values: enumValues(hdmiFormatNames), Idempotent: true},
+	{Name: "color_space", Opcode: 0x1219, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 3, Values: enumValues(colorSpaceNames), Idempotent: true},
+	{Name: "dcr", Opcode: 0x121A, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "test_pattern", Opcode: 0x121B, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 3, Values: enumValues(testPatternNames), Idempotent: true},
+	{Name: "zoom_in", Opcode: 0x121D, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "zoom_out", Opcode: 0x121E, Access: ACCESS_WRITE, Type: VALUE_NONE},
+	{Name: "digital_zoom", Opcode: 0x121C, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 10, Idempotent: true},
+	{Name: "screen_color", Opcode: 0x121F, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 3, Values: enumValues(screenColorNames), Idempotent: true},
+	{Name: "lamp_mode", Opcode: 0x1110, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 3, Values: enumValues(lampModeNames), Idempotent: true},
+	{Name: "lamp_hours_reset", Opcode: 0x1502, Access: ACCESS_WRITE, Type: VALUE_NONE, Verify: "lamp_hours", VerifyValue: 0, Confirm: true},
+	{Name: "lamp_hours_2", Opcode: 0x1503, Access: ACCESS_READ, Type: VALUE_UINT32},
+	{Name: "active_lamp", Opcode: 0x1504, Access: ACCESS_READ, Type: VALUE_UINT8},
+	{Name: "light_source_hours", Opcode: 0x1505, Access: ACCESS_READ, Type: VALUE_UINT32},
+	{Name: "light_power_level", Opcode: 0x1111, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 100, Idempotent: true},
+	{Name: "filter_hours", Opcode: 0x1506, Access: ACCESS_READ, Type: VALUE_UINT32},
+	{Name: "filter_hours_reset", Opcode: 0x1507, Access: ACCESS_WRITE, Type: VALUE_NONE, Verify: "filter_hours", VerifyValue: 0, Confirm: true},
+	{Name: "filter_mode", Opcode: 0x1508, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_BOOL, Idempotent: true},
+	{Name: "3d_mode", Opcode: 0x1230, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_UINT8, Min: 0, Max: 5, Idempotent: true},
+	{Name: "lens_shift", Opcode: 0x1231, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT16, Min: -100, Max: 100, Idempotent: true},
+	{Name: "h_position", Opcode: 0x1226, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT16, Min: -50, Max: 50, Idempotent: true},
+	{Name: "v_position", Opcode: 0x1227, Access: ACCESS_READ | ACCESS_WRITE, Type: VALUE_INT16, Min: -50, Max: 50, Idempotent: true},
+}
+
+// PowerState reports whether the projector is on.
+func (p *Projector) PowerState() (bool, error) {
+	return p.PowerStateContext(context.Background())
+}
+
+func (p *Projector) PowerStateContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "power")
+	return v != 0, err
+}
+
+func (p *Projector) PowerOn() error {
+	return p.PowerOnContext(context.Background())
+}
+
+func (p *Projector) PowerOnContext(ctx context.Context) error {
+	return p.set(ctx, "power_on", 0)
+}
+
+func (p *Projector) PowerOff() error {
+	return p.PowerOffContext(context.Background())
+}
+
+func (p *Projector) PowerOffContext(ctx context.Context) error {
+	return p.set(ctx, "power_off", 0)
+}
+
+// LampHours returns the hours run on the current lamp.
+func (p *Projector) LampHours() (uint32, error) {
+	return p.LampHoursContext(context.Background())
+}
+
+func (p *Projector) LampHoursContext(ctx context.Context) (uint32, error) {
+	v, err := p.get(ctx, "lamp_hours")
+	return uint32(v), err
+}
+
+// Source returns the selected video input.
+func (p *Projector) Source() (Source, error) {
+	return p.SourceContext(context.Background())
+}
+
+func (p *Projector) SourceContext(ctx context.Context) (Source, error) {
+	v, err := p.get(ctx, "source")
+	return Source(v), err
+}
+
+func (p *Projector) SetSource(v Source) error {
+	return p.SetSourceContext(context.Background(), v)
+}
+
+func (p *Projector) SetSourceContext(ctx context.Context, v Source) error {
+	return p.set(ctx, "source", int(v))
+}
+
+// QuickAutoSearch reports whether the projector hunts for an active input when the signal is lost.
+func (p *Projector) QuickAutoSearch() (bool, error) {
+	return p.QuickAutoSearchContext(context.Background())
+}
+
+func (p *Projector) QuickAutoSearchContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "quick_auto_search")
+	return v != 0, err
+}
+
+func (p *Projector) SetQuickAutoSearch(v bool) error {
+	return p.SetQuickAutoSearchContext(context.Background(), v)
+}
+
+func (p *Projector) SetQuickAutoSearchContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "quick_auto_search", boolValue(v))
+}
+
+// Volume returns the speaker volume.
+func (p *Projector) Volume() (uint8, error) {
+	return p.VolumeContext(context.Background())
+}
+
+func (p *Projector) VolumeContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "volume")
+	return uint8(v), err
+}
+
+func (p *Projector) SetVolume(v uint8) error {
+	return p.SetVolumeContext(context.Background(), v)
+}
+
+func (p *Projector) SetVolumeContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "volume", int(v))
+}
+
+// VolumeUp raises the volume one step.
+func (p *Projector) VolumeUp() error {
+	return p.VolumeUpContext(context.Background())
+}
+
+func (p *Projector) VolumeUpContext(ctx context.Context) error {
+	return p.set(ctx, "volume_up", 0)
+}
+
+// VolumeDown lowers the volume one step.
+func (p *Projector) VolumeDown() error {
+	return p.VolumeDownContext(context.Background())
+}
+
+func (p *Projector) VolumeDownContext(ctx context.Context) error {
+	return p.set(ctx, "volume_down", 0)
+}
+
+// Mute reports whether the audio is muted.
+func (p *Projector) Mute() (bool, error) {
+	return p.MuteContext(context.Background())
+}
+
+func (p *Projector) MuteContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "mute")
+	return v != 0, err
+}
+
+func (p *Projector) SetMute(v bool) error {
+	return p.SetMuteContext(context.Background(), v)
+}
+
+func (p *Projector) SetMuteContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "mute", boolValue(v))
+}
+
+// MicVolume returns the microphone input volume.
+func (p *Projector) MicVolume() (uint8, error) {
+	return p.MicVolumeContext(context.Background())
+}
+
+func (p *Projector) MicVolumeContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "mic_volume")
+	return uint8(v), err
+}
+
+func (p *Projector) SetMicVolume(v uint8) error {
+	return p.SetMicVolumeContext(context.Background(), v)
+}
+
+func (p *Projector) SetMicVolumeContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "mic_volume", int(v))
+}
+
+// Treble returns the treble adjustment of the onboard speaker.
+func (p *Projector) Treble() (int8, error) {
+	return p.TrebleContext(context.Background())
+}
+
+func (p *Projector) TrebleContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "treble")
+	return int8(v), err
+}
+
+func (p *Projector) SetTreble(v int8) error {
+	return p.SetTrebleContext(context.Background(), v)
+}
+
+func (p *Projector) SetTrebleContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "treble", int(v))
+}
+
+// Bass returns the bass adjustment of the onboard speaker.
+func (p *Projector) Bass() (int8, error) {
+	return p.BassContext(context.Background())
+}
+
+func (p *Projector) BassContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "bass")
+	return int8(v), err
+}
+
+func (p *Projector) SetBass(v int8) error {
+	return p.SetBassContext(context.Background(), v)
+}
+
+func (p *Projector) SetBassContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "bass", int(v))
+}
+
+// AudioSource returns the input the audio is taken from.
+func (p *Projector) AudioSource() (AudioSource, error) {
+	return p.AudioSourceContext(context.Background())
+}
+
+func (p *Projector) AudioSourceContext(ctx context.Context) (AudioSource, error) {
+	v, err := p.get(ctx, "audio_source")
+	return AudioSource(v), err
+}
+
+func (p *Projector) SetAudioSource(v AudioSource) error {
+	return p.SetAudioSourceContext(context.Background(), v)
+}
+
+func (p *Projector) SetAudioSourceContext(ctx context.Context, v AudioSource) error {
+	return p.set(ctx, "audio_source", int(v))
+}
+
+// Blank reports whether the picture is blanked.
+func (p *Projector) Blank() (bool, error) {
+	return p.BlankContext(context.Background())
+}
+
+func (p *Projector) BlankContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "blank")
+	return v != 0, err
+}
+
+func (p *Projector) SetBlank(v bool) error {
+	return p.SetBlankContext(context.Background(), v)
+}
+
+func (p *Projector) SetBlankContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "blank", boolValue(v))
+}
+
+// Freeze reports whether the picture is frozen.
+func (p *Projector) Freeze() (bool, error) {
+	return p.FreezeContext(context.Background())
+}
+
+func (p *Projector) FreezeContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "freeze")
+	return v != 0, err
+}
+
+func (p *Projector) SetFreeze(v bool) error {
+	return p.SetFreezeContext(context.Background(), v)
+}
+
+func (p *Projector) SetFreezeContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "freeze", boolValue(v))
+}
+
+// KeystoneV returns the vertical keystone correction.
+func (p *Projector) KeystoneV() (int8, error) {
+	return p.KeystoneVContext(context.Background())
+}
+
+func (p *Projector) KeystoneVContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "keystone_v")
+	return int8(v), err
+}
+
+func (p *Projector) SetKeystoneV(v int8) error {
+	return p.SetKeystoneVContext(context.Background(), v)
+}
+
+func (p *Projector) SetKeystoneVContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "keystone_v", int(v))
+}
+
+// KeystoneVUp raises the vertical keystone correction one step.
+func (p *Projector) KeystoneVUp() error {
+	return p.KeystoneVUpContext(context.Background())
+}
+
+func (p *Projector) KeystoneVUpContext(ctx context.Context) error {
+	return p.set(ctx, "keystone_v_up", 0)
+}
+
+// KeystoneVDown lowers the vertical keystone correction one step.
+func (p *Projector) KeystoneVDown() error {
+	return p.KeystoneVDownContext(context.Background())
+}
+
+func (p *Projector) KeystoneVDownContext(ctx context.Context) error {
+	return p.set(ctx, "keystone_v_down", 0)
+}
+
+// KeystoneH returns the horizontal keystone correction on models that have it.
+func (p *Projector) KeystoneH() (int8, error) {
+	return p.KeystoneHContext(context.Background())
+}
+
+func (p *Projector) KeystoneHContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "keystone_h")
+	return int8(v), err
+}
+
+func (p *Projector) SetKeystoneH(v int8) error {
+	return p.SetKeystoneHContext(context.Background(), v)
+}
+
+func (p *Projector) SetKeystoneHContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "keystone_h", int(v))
+}
+
+// AutoKeystone reports whether keystone is corrected automatically.
+func (p *Projector) AutoKeystone() (bool, error) {
+	return p.AutoKeystoneContext(context.Background())
+}
+
+func (p *Projector) AutoKeystoneContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "auto_keystone")
+	return v != 0, err
+}
+
+func (p *Projector) SetAutoKeystone(v bool) error {
+	return p.SetAutoKeystoneContext(context.Background(), v)
+}
+
+func (p *Projector) SetAutoKeystoneContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "auto_keystone", boolValue(v))
+}
+
+// Brightness returns the picture brightness on a 0 to 100 scale.
+func (p *Projector) Brightness() (uint8, error) {
+	return p.BrightnessContext(context.Background())
+}
+
+func (p *Projector) BrightnessContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "brightness")
+	return uint8(v), err
+}
+
+func (p *Projector) SetBrightness(v uint8) error {
+	return p.SetBrightnessContext(context.Background(), v)
+}
+
+func (p *Projector) SetBrightnessContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "brightness", int(v))
+}
+
+// Contrast returns the picture contrast on the 0 to 100 scale shown in the OSD.
+func (p *Projector) Contrast() (uint8, error) {
+	return p.ContrastContext(context.Background())
+}
+
+func (p *Projector) ContrastContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "contrast")
+	return uint8(v), err
+}
+
+func (p *Projector) SetContrast(v uint8) error {
+	return p.SetContrastContext(context.Background(), v)
+}
+
+func (p *Projector) SetContrastContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "contrast", int(v))
+}
+
+// Sharpness returns the picture sharpness.
+func (p *Projector) Sharpness() (uint8, error) {
+	return p.SharpnessContext(context.Background())
+}
+
+func (p *Projector) SharpnessContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "sharpness")
+	return uint8(v), err
+}
+
+func (p *Projector) SetSharpness(v uint8) error {
+	return p.SetSharpnessContext(context.Background(), v)
+}
+
+func (p *Projector) SetSharpnessContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "sharpness", int(v))
+}
+
+// ColorTemperature returns the color temperature preset.
+func (p *Projector) ColorTemperature() (ColorTemperature, error) {
+	return p.ColorTemperatureContext(context.Background())
+}
+
+func (p *Projector) ColorTemperatureContext(ctx context.Context) (ColorTemperature, error) {
+	v, err := p.get(ctx, "color_temperature")
+	return ColorTemperature(v), err
+}
+
+func (p *Projector) SetColorTemperature(v ColorTemperature) error {
+	return p.SetColorTemperatureContext(context.Background(), v)
+}
+
+func (p *Projector) SetColorTemperatureContext(ctx context.Context, v ColorTemperature) error {
+	return p.set(ctx, "color_temperature", int(v))
+}
+
+// RedGain returns the red gain of the white balance.
+func (p *Projector) RedGain() (uint8, error) {
+	return p.RedGainContext(context.Background())
+}
+
+func (p *Projector) RedGainContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "red_gain")
+	return uint8(v), err
+}
+
+func (p *Projector) SetRedGain(v uint8) error {
+	return p.SetRedGainContext(context.Background(), v)
+}
+
+func (p *Projector) SetRedGainContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "red_gain", int(v))
+}
+
+// GreenGain returns the green gain of the white balance.
+func (p *Projector) GreenGain() (uint8, error) {
+	return p.GreenGainContext(context.Background())
+}
+
+func (p *Projector) GreenGainContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "green_gain")
+	return uint8(v), err
+}
+
+func (p *Projector) SetGreenGain(v uint8) error {
+	return p.SetGreenGainContext(context.Background(), v)
+}
+
+func (p *Projector) SetGreenGainContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "green_gain", int(v))
+}
+
+// BlueGain returns the blue gain of the white balance.
+func (p *Projector) BlueGain() (uint8, error) {
+	return p.BlueGainContext(context.Background())
+}
+
+func (p *Projector) BlueGainContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "blue_gain")
+	return uint8(v), err
+}
+
+func (p *Projector) SetBlueGain(v uint8) error {
+	return p.SetBlueGainContext(context.Background(), v)
+}
+
+func (p *Projector) SetBlueGainContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "blue_gain", int(v))
+}
+
+// RedOffset returns the red offset of the white balance.
+func (p *Projector) RedOffset() (int8, error) {
+	return p.RedOffsetContext(context.Background())
+}
+
+func (p *Projector) RedOffsetContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "red_offset")
+	return int8(v), err
+}
+
+func (p *Projector) SetRedOffset(v int8) error {
+	return p.SetRedOffsetContext(context.Background(), v)
+}
+
+func (p *Projector) SetRedOffsetContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "red_offset", int(v))
+}
+
+// GreenOffset returns the green offset of the white balance.
+func (p *Projector) GreenOffset() (int8, error) {
+	return p.GreenOffsetContext(context.Background())
+}
+
+func (p *Projector) GreenOffsetContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "green_offset")
+	return int8(v), err
+}
+
+func (p *Projector) SetGreenOffset(v int8) error {
+	return p.SetGreenOffsetContext(context.Background(), v)
+}
+
+func (p *Projector) SetGreenOffsetContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "green_offset", int(v))
+}
+
+// BlueOffset returns the blue offset of the white balance.
+func (p *Projector) BlueOffset() (int8, error) {
+	return p.BlueOffsetContext(context.Background())
+}
+
+func (p *Projector) BlueOffsetContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "blue_offset")
+	return int8(v), err
+}
+
+func (p *Projector) SetBlueOffset(v int8) error {
+	return p.SetBlueOffsetContext(context.Background(), v)
+}
+
+func (p *Projector) SetBlueOffsetContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "blue_offset", int(v))
+}
+
+// AspectRatio returns the aspect ratio setting.
+func (p *Projector) AspectRatio() (AspectRatio, error) {
+	return p.AspectRatioContext(context.Background())
+}
+
+func (p *Projector) AspectRatioContext(ctx context.Context) (AspectRatio, error) {
+	v, err := p.get(ctx, "aspect_ratio")
+	return AspectRatio(v), err
+}
+
+func (p *Projector) SetAspectRatio(v AspectRatio) error {
+	return p.SetAspectRatioContext(context.Background(), v)
+}
+
+func (p *Projector) SetAspectRatioContext(ctx context.Context, v AspectRatio) error {
+	return p.set(ctx, "aspect_ratio", int(v))
+}
+
+// ColorMode returns the picture mode preset.
+func (p *Projector) ColorMode() (ColorMode, error) {
+	return p.ColorModeContext(context.Background())
+}
+
+func (p *Projector) ColorModeContext(ctx context.Context) (ColorMode, error) {
+	v, err := p.get(ctx, "color_mode")
+	return ColorMode(v), err
+}
+
+func (p *Projector) SetColorMode(v ColorMode) error {
+	return p.SetColorModeContext(context.Background(), v)
+}
+
+func (p *Projector) SetColorModeContext(ctx context.Context, v ColorMode) error {
+	return p.set(ctx, "color_mode", int(v))
+}
+
+// Gamma returns the gamma curve.
+func (p *Projector) Gamma() (Gamma, error) {
+	return p.GammaContext(context.Background())
+}
+
+func (p *Projector) GammaContext(ctx context.Context) (Gamma, error) {
+	v, err := p.get(ctx, "gamma")
+	return Gamma(v), err
+}
+
+func (p *Projector) SetGamma(v Gamma) error {
+	return p.SetGammaContext(context.Background(), v)
+}
+
+func (p *Projector) SetGammaContext(ctx context.Context, v Gamma) error {
+	return p.set(ctx, "gamma", int(v))
+}
+
+// Hue returns the hue (tint) adjustment for component and video sources.
+func (p *Projector) Hue() (int8, error) {
+	return p.HueContext(context.Background())
+}
+
+func (p *Projector) HueContext(ctx context.Context) (int8, error) {
+	v, err := p.get(ctx, "hue")
+	return int8(v), err
+}
+
+func (p *Projector) SetHue(v int8) error {
+	return p.SetHueContext(context.Background(), v)
+}
+
+func (p *Projector) SetHueContext(ctx context.Context, v int8) error {
+	return p.set(ctx, "hue", int(v))
+}
+
+// Saturation returns the color saturation.
+func (p *Projector) Saturation() (uint8, error) {
+	return p.SaturationContext(context.Background())
+}
+
+func (p *Projector) SaturationContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "saturation")
+	return uint8(v), err
+}
+
+func (p *Projector) SetSaturation(v uint8) error {
+	return p.SetSaturationContext(context.Background(), v)
+}
+
+func (p *Projector) SetSaturationContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "saturation", int(v))
+}
+
+// ColorGain returns the color gain.
+func (p *Projector) ColorGain() (uint8, error) {
+	return p.ColorGainContext(context.Background())
+}
+
+func (p *Projector) ColorGainContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "color_gain")
+	return uint8(v), err
+}
+
+func (p *Projector) SetColorGain(v uint8) error {
+	return p.SetColorGainContext(context.Background(), v)
+}
+
+func (p *Projector) SetColorGainContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "color_gain", int(v))
+}
+
+// BrilliantColor returns the DLP BrilliantColor level on models that have it.
+func (p *Projector) BrilliantColor() (uint8, error) {
+	return p.BrilliantColorContext(context.Background())
+}
+
+func (p *Projector) BrilliantColorContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "brilliant_color")
+	return uint8(v), err
+}
+
+func (p *Projector) SetBrilliantColor(v uint8) error {
+	return p.SetBrilliantColorContext(context.Background(), v)
+}
+
+func (p *Projector) SetBrilliantColorContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "brilliant_color", int(v))
+}
+
+// Overscan returns how far the picture edges are cropped.
+func (p *Projector) Overscan() (uint8, error) {
+	return p.OverscanContext(context.Background())
+}
+
+func (p *Projector) OverscanContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "overscan")
+	return uint8(v), err
+}
+
+func (p *Projector) SetOverscan(v uint8) error {
+	return p.SetOverscanContext(context.Background(), v)
+}
+
+func (p *Projector) SetOverscanContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "overscan", int(v))
+}
+
+// NoiseReduction returns the noise reduction level for analog and video inputs.
+func (p *Projector) NoiseReduction() (uint8, error) {
+	return p.NoiseReductionContext(context.Background())
+}
+
+func (p *Projector) NoiseReductionContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "noise_reduction")
+	return uint8(v), err
+}
+
+func (p *Projector) SetNoiseReduction(v uint8) error {
+	return p.SetNoiseReductionContext(context.Background(), v)
+}
+
+func (p *Projector) SetNoiseReductionContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "noise_reduction", int(v))
+}
+
+// FilmMode reports whether 3:2 pulldown detection is on.
+func (p *Projector) FilmMode() (bool, error) {
+	return p.FilmModeContext(context.Background())
+}
+
+func (p *Projector) FilmModeContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "film_mode")
+	return v != 0, err
+}
+
+func (p *Projector) SetFilmMode(v bool) error {
+	return p.SetFilmModeContext(context.Background(), v)
+}
+
+func (p *Projector) SetFilmModeContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "film_mode", boolValue(v))
+}
+
+// HDMIRange returns the RGB range expected on HDMI.
+func (p *Projector) HDMIRange() (HDMIRange, error) {
+	return p.HDMIRangeContext(context.Background())
+}
+
+func (p *Projector) HDMIRangeContext(ctx context.Context) (HDMIRange, error) {
+	v, err := p.get(ctx, "hdmi_range")
+	return HDMIRange(v), err
+}
+
+func (p *Projector) SetHDMIRange(v HDMIRange) error {
+	return p.SetHDMIRangeContext(context.Background(), v)
+}
+
+func (p *Projector) SetHDMIRangeContext(ctx context.Context, v HDMIRange) error {
+	return p.set(ctx, "hdmi_range", int(v))
+}
+
+// HDMIFormat returns the signal format expected on HDMI.
+func (p *Projector) HDMIFormat() (HDMIFormat, error) {
+	return p.HDMIFormatContext(context.Background())
+}
+
+func (p *Projector) HDMIFormatContext(ctx context.Context) (HDMIFormat, error) {
+	v, err := p.get(ctx, "hdmi_format")
+	return HDMIFormat(v), err
+}
+
+func (p *Projector) SetHDMIFormat(v HDMIFormat) error {
+	return p.SetHDMIFormatContext(context.Background(), v)
+}
+
+func (p *Projector) SetHDMIFormatContext(ctx context.Context, v HDMIFormat) error {
+	return p.set(ctx, "hdmi_format", int(v))
+}
+
+// ColorSpace returns the input color space on models that expose it.
+func (p *Projector) ColorSpace() (ColorSpace, error) {
+	return p.ColorSpaceContext(context.Background())
+}
+
+func (p *Projector) ColorSpaceContext(ctx context.Context) (ColorSpace, error) {
+	v, err := p.get(ctx, "color_space")
+	return ColorSpace(v), err
+}
+
+func (p *Projector) SetColorSpace(v ColorSpace) error {
+	return p.SetColorSpaceContext(context.Background(), v)
+}
+
+func (p *Projector) SetColorSpaceContext(ctx context.Context, v ColorSpace) error {
+	return p.set(ctx, "color_space", int(v))
+}
+
+// DCR reports whether dynamic contrast is on.
+func (p *Projector) DCR() (bool, error) {
+	return p.DCRContext(context.Background())
+}
+
+func (p *Projector) DCRContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "dcr")
+	return v != 0, err
+}
+
+func (p *Projector) SetDCR(v bool) error {
+	return p.SetDCRContext(context.Background(), v)
+}
+
+func (p *Projector) SetDCRContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "dcr", boolValue(v))
+}
+
+// TestPattern returns the built-in test pattern on screen.
+func (p *Projector) TestPattern() (TestPattern, error) {
+	return p.TestPatternContext(context.Background())
+}
+
+func (p *Projector) TestPatternContext(ctx context.Context) (TestPattern, error) {
+	v, err := p.get(ctx, "test_pattern")
+	return TestPattern(v), err
+}
+
+func (p *Projector) SetTestPattern(v TestPattern) error {
+	return p.SetTestPatternContext(context.Background(), v)
+}
+
+func (p *Projector) SetTestPatternContext(ctx context.Context, v TestPattern) error {
+	return p.set(ctx, "test_pattern", int(v))
+}
+
+// ZoomIn enlarges the picture one digital zoom step.
+func (p *Projector) ZoomIn() error {
+	return p.ZoomInContext(context.Background())
+}
+
+func (p *Projector) ZoomInContext(ctx context.Context) error {
+	return p.set(ctx, "zoom_in", 0)
+}
+
+// ZoomOut shrinks the picture one digital zoom step.
+func (p *Projector) ZoomOut() error {
+	return p.ZoomOutContext(context.Background())
+}
+
+func (p *Projector) ZoomOutContext(ctx context.Context) error {
+	return p.set(ctx, "zoom_out", 0)
+}
+
+// DigitalZoom returns the digital zoom level on models that can set it directly.
+func (p *Projector) DigitalZoom() (uint8, error) {
+	return p.DigitalZoomContext(context.Background())
+}
+
+func (p *Projector) DigitalZoomContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "digital_zoom")
+	return uint8(v), err
+}
+
+func (p *Projector) SetDigitalZoom(v uint8) error {
+	return p.SetDigitalZoomContext(context.Background(), v)
+}
+
+func (p *Projector) SetDigitalZoomContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "digital_zoom", int(v))
+}
+
+// ScreenColor returns the wall color compensation preset.
+func (p *Projector) ScreenColor() (ScreenColor, error) {
+	return p.ScreenColorContext(context.Background())
+}
+
+func (p *Projector) ScreenColorContext(ctx context.Context) (ScreenColor, error) {
+	v, err := p.get(ctx, "screen_color")
+	return ScreenColor(v), err
+}
+
+func (p *Projector) SetScreenColor(v ScreenColor) error {
+	return p.SetScreenColorContext(context.Background(), v)
+}
+
+func (p *Projector) SetScreenColorContext(ctx context.Context, v ScreenColor) error {
+	return p.set(ctx, "screen_color", int(v))
+}
+
+// LampMode returns the lamp power mode.
+func (p *Projector) LampMode() (LampMode, error) {
+	return p.LampModeContext(context.Background())
+}
+
+func (p *Projector) LampModeContext(ctx context.Context) (LampMode, error) {
+	v, err := p.get(ctx, "lamp_mode")
+	return LampMode(v), err
+}
+
+func (p *Projector) SetLampMode(v LampMode) error {
+	return p.SetLampModeContext(context.Background(), v)
+}
+
+func (p *Projector) SetLampModeContext(ctx context.Context, v LampMode) error {
+	return p.set(ctx, "lamp_mode", int(v))
+}
+
+func (p *Projector) resetLampHoursContext(ctx context.Context, force bool) error {
+	return p.setForced(ctx, "lamp_hours_reset", 0, force)
+}
+
+// LampHours2 returns the hours run on the second lamp of dual-lamp models.
+func (p *Projector) LampHours2() (uint32, error) {
+	return p.LampHours2Context(context.Background())
+}
+
+func (p *Projector) LampHours2Context(ctx context.Context) (uint32, error) {
+	v, err := p.get(ctx, "lamp_hours_2")
+	return uint32(v), err
+}
+
+// ActiveLamp returns which lamp of a dual-lamp model is lit: 1 or 2.
+func (p *Projector) ActiveLamp() (uint8, error) {
+	return p.ActiveLampContext(context.Background())
+}
+
+func (p *Projector) ActiveLampContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "active_lamp")
+	return uint8(v), err
+}
+
+// LightSourceHours returns the hours run on the laser light source.
+func (p *Projector) LightSourceHours() (uint32, error) {
+	return p.LightSourceHoursContext(context.Background())
+}
+
+func (p *Projector) LightSourceHoursContext(ctx context.Context) (uint32, error) {
+	v, err := p.get(ctx, "light_source_hours")
+	return uint32(v), err
+}
+
+// LightPowerLevel returns the laser light output in percent.
+func (p *Projector) LightPowerLevel() (uint8, error) {
+	return p.LightPowerLevelContext(context.Background())
+}
+
+func (p *Projector) LightPowerLevelContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "light_power_level")
+	return uint8(v), err
+}
+
+func (p *Projector) SetLightPowerLevel(v uint8) error {
+	return p.SetLightPowerLevelContext(context.Background(), v)
+}
+
+func (p *Projector) SetLightPowerLevelContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "light_power_level", int(v))
+}
+
+// FilterHours returns the hours run since the dust filter was last cleaned.
+func (p *Projector) FilterHours() (uint32, error) {
+	return p.FilterHoursContext(context.Background())
+}
+
+func (p *Projector) FilterHoursContext(ctx context.Context) (uint32, error) {
+	v, err := p.get(ctx, "filter_hours")
+	return uint32(v), err
+}
+
+func (p *Projector) resetFilterHoursContext(ctx context.Context, force bool) error {
+	return p.setForced(ctx, "filter_hours_reset", 0, force)
+}
+
+// FilterMode reports whether the optional dust filter is marked as fitted so its hour timer runs.
+func (p *Projector) FilterMode() (bool, error) {
+	return p.FilterModeContext(context.Background())
+}
+
+func (p *Projector) FilterModeContext(ctx context.Context) (bool, error) {
+	v, err := p.get(ctx, "filter_mode")
+	return v != 0, err
+}
+
+func (p *Projector) SetFilterMode(v bool) error {
+	return p.SetFilterModeContext(context.Background(), v)
+}
+
+func (p *Projector) SetFilterModeContext(ctx context.Context, v bool) error {
+	return p.set(ctx, "filter_mode", boolValue(v))
+}
+
+// Mode3D returns the 3D sync format on 3D-capable models; 0 is off.
+func (p *Projector) Mode3D() (uint8, error) {
+	return p.Mode3DContext(context.Background())
+}
+
+func (p *Projector) Mode3DContext(ctx context.Context) (uint8, error) {
+	v, err := p.get(ctx, "3d_mode")
+	return uint8(v), err
+}
+
+func (p *Projector) SetMode3D(v uint8) error {
+	return p.SetMode3DContext(context.Background(), v)
+}
+
+func (p *Projector) SetMode3DContext(ctx context.Context, v uint8) error {
+	return p.set(ctx, "3d_mode", int(v))
+}
+
+// LensShift returns the vertical lens shift on models with a motorized lens.
+func (p *Projector) LensShift() (int16, error) {
+	return p.LensShiftContext(context.Background())
+}
+
+func (p *Projector) LensShiftContext(ctx context.Context) (int16, error) {
+	v, err := p.get(ctx, "lens_shift")
+	return int16(v), err
+}
+
+func (p *Projector) SetLensShift(v int16) error {
+	return p.SetLensShiftContext(context.Background(), v)
+}
+
+func (p *Projector) SetLensShiftContext(ctx context.Context, v int16) error {
+	return p.set(ctx, "lens_shift", int(v))
+}
+
+// HPosition returns the horizontal picture position offset from center.
+func (p *Projector) HPosition() (int16, error) {
+	return p.HPositionContext(context.Background())
+}
+
+func (p *Projector) HPositionContext(ctx context.Context) (int16, error) {
+	v, err := p.get(ctx, "h_position")
+	return int16(v), err
+}
+
+func (p *Projector) SetHPosition(v int16) error {
+	return p.SetHPositionContext(context.Background(), v)
+}
+
+func (p *Projector) SetHPositionContext(ctx context.Context, v int16) error {
+	return p.set(ctx, "h_position", int(v))
+}
+
+// VPosition returns the vertical picture position offset from center.
+func (p *Projector) VPosition() (int16, error) {
+	return p.VPositionContext(context.Background())
+}
+
+func (p *Projector) VPositionContext(ctx context.Context) (int16, error) {
+	v, err := p.get(ctx, "v_position")
+	return int16(v), err
+}
+
+func (p *Projector) SetVPosition(v int16) error {
+	return p.SetVPositionContext(context.Background(), v)
+}
+
+func (p *Projector) SetVPositionContext(ctx context.Context, v int16) error {
+	return p.set(ctx, "v_position", int(v))
+}
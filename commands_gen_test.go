@@ -0,0 +1,1324 @@
+// Code generated by vsgen from commands.csv; DO NOT EDIT.
+
+package projector_test
+
+import (
+	"bytes"
+	"testing"
+
+	projector "github.com/echo1001/go-viewsonic"
+	"github.com/echo1001/go-viewsonic/mocktransport"
+)
+
+func expectFrame(t *testing.T, mock *mocktransport.Transport, name string, command projector.CommandType, data ...byte) {
+	t.Helper()
+	want := projector.Packet{Command: command, Data: data}
+	if got := bytes.Join(mock.Written(), nil); !bytes.Equal(got, want.Build()) {
+		t.Errorf("%s wrote % x, want % x", name, got, want.Build())
+	}
+}
+
+// newProjector opens mock under the profile the named command is exclusive
+// to, if any.
+func newProjector(mock *mocktransport.Transport, name string) *projector.Projector {
+	for _, m := range projector.ModelProfiles {
+		for _, exclusive := range m.Exclusive {
+			if exclusive == name {
+				return projector.New(mock, projector.WithModel(m))
+			}
+		}
+	}
+	return projector.New(mock)
+}
+
+func TestPowerState(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "power")
+	v, err := p.PowerState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("PowerState = %v, want true", v)
+	}
+	expectFrame(t, mock, "PowerState", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x11, 0x00)
+}
+
+func TestPowerOn(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "power_on")
+	if err := p.PowerOn(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "PowerOn", projector.COMMAND_WRITE, 0x34, 0x11, 0x00, 0x00)
+}
+
+func TestPowerOff(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "power_off")
+	if err := p.PowerOff(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "PowerOff", projector.COMMAND_WRITE, 0x34, 0x11, 0x01, 0x00)
+}
+
+func TestLampHours(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x04, 0x03, 0x02, 0x01))
+	p := newProjector(mock, "lamp_hours")
+	v, err := p.LampHours()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 16909060 {
+		t.Errorf("LampHours = %v, want 16909060", v)
+	}
+	expectFrame(t, mock, "LampHours", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x01)
+}
+
+func TestSource(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0f))
+	p := newProjector(mock, "source")
+	v, err := p.Source()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 15 {
+		t.Errorf("Source = %v, want 15", v)
+	}
+	expectFrame(t, mock, "Source", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x13, 0x01)
+}
+
+func TestSetSource(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "source")
+	if err := p.SetSource(15); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetSource", projector.COMMAND_WRITE, 0x34, 0x13, 0x01, 0x0f)
+}
+
+func TestQuickAutoSearch(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "quick_auto_search")
+	v, err := p.QuickAutoSearch()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("QuickAutoSearch = %v, want true", v)
+	}
+	expectFrame(t, mock, "QuickAutoSearch", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x13, 0x02)
+}
+
+func TestSetQuickAutoSearch(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "quick_auto_search")
+	if err := p.SetQuickAutoSearch(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetQuickAutoSearch", projector.COMMAND_WRITE, 0x34, 0x13, 0x02, 0x01)
+}
+
+func TestVolume(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x14))
+	p := newProjector(mock, "volume")
+	v, err := p.Volume()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 20 {
+		t.Errorf("Volume = %v, want 20", v)
+	}
+	expectFrame(t, mock, "Volume", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x03)
+}
+
+func TestSetVolume(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "volume")
+	if err := p.SetVolume(20); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetVolume", projector.COMMAND_WRITE, 0x34, 0x14, 0x03, 0x14)
+}
+
+func TestVolumeUp(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "volume_up")
+	if err := p.VolumeUp(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "VolumeUp", projector.COMMAND_WRITE, 0x34, 0x14, 0x01, 0x00)
+}
+
+func TestVolumeDown(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "volume_down")
+	if err := p.VolumeDown(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "VolumeDown", projector.COMMAND_WRITE, 0x34, 0x14, 0x02, 0x00)
+}
+
+func TestMute(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "mute")
+	v, err := p.Mute()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("Mute = %v, want true", v)
+	}
+	expectFrame(t, mock, "Mute", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x00)
+}
+
+func TestSetMute(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "mute")
+	if err := p.SetMute(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetMute", projector.COMMAND_WRITE, 0x34, 0x14, 0x00, 0x01)
+}
+
+func TestMicVolume(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x14))
+	p := newProjector(mock, "mic_volume")
+	v, err := p.MicVolume()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 20 {
+		t.Errorf("MicVolume = %v, want 20", v)
+	}
+	expectFrame(t, mock, "MicVolume", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x04)
+}
+
+func TestSetMicVolume(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "mic_volume")
+	if err := p.SetMicVolume(20); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetMicVolume", projector.COMMAND_WRITE, 0x34, 0x14, 0x04, 0x14)
+}
+
+func TestTreble(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xf6))
+	p := newProjector(mock, "treble")
+	v, err := p.Treble()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -10 {
+		t.Errorf("Treble = %v, want -10", v)
+	}
+	expectFrame(t, mock, "Treble", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x05)
+}
+
+func TestSetTreble(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "treble")
+	if err := p.SetTreble(-10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetTreble", projector.COMMAND_WRITE, 0x34, 0x14, 0x05, 0xf6)
+}
+
+func TestBass(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xf6))
+	p := newProjector(mock, "bass")
+	v, err := p.Bass()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -10 {
+		t.Errorf("Bass = %v, want -10", v)
+	}
+	expectFrame(t, mock, "Bass", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x06)
+}
+
+func TestSetBass(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "bass")
+	if err := p.SetBass(-10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBass", projector.COMMAND_WRITE, 0x34, 0x14, 0x06, 0xf6)
+}
+
+func TestAudioSource(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x02))
+	p := newProjector(mock, "audio_source")
+	v, err := p.AudioSource()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("AudioSource = %v, want 2", v)
+	}
+	expectFrame(t, mock, "AudioSource", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x14, 0x07)
+}
+
+func TestSetAudioSource(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "audio_source")
+	if err := p.SetAudioSource(2); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetAudioSource", projector.COMMAND_WRITE, 0x34, 0x14, 0x07, 0x02)
+}
+
+func TestBlank(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "blank")
+	v, err := p.Blank()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("Blank = %v, want true", v)
+	}
+	expectFrame(t, mock, "Blank", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x09)
+}
+
+func TestSetBlank(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "blank")
+	if err := p.SetBlank(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBlank", projector.COMMAND_WRITE, 0x34, 0x12, 0x09, 0x01)
+}
+
+func TestFreeze(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "freeze")
+	v, err := p.Freeze()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("Freeze = %v, want true", v)
+	}
+	expectFrame(t, mock, "Freeze", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x13, 0x00)
+}
+
+func TestSetFreeze(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "freeze")
+	if err := p.SetFreeze(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetFreeze", projector.COMMAND_WRITE, 0x34, 0x13, 0x00, 0x01)
+}
+
+func TestKeystoneV(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xd8))
+	p := newProjector(mock, "keystone_v")
+	v, err := p.KeystoneV()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -40 {
+		t.Errorf("KeystoneV = %v, want -40", v)
+	}
+	expectFrame(t, mock, "KeystoneV", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0A)
+}
+
+func TestSetKeystoneV(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "keystone_v")
+	if err := p.SetKeystoneV(-40); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetKeystoneV", projector.COMMAND_WRITE, 0x34, 0x12, 0x0A, 0xd8)
+}
+
+func TestKeystoneVUp(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "keystone_v_up")
+	if err := p.KeystoneVUp(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "KeystoneVUp", projector.COMMAND_WRITE, 0x34, 0x12, 0x28, 0x00)
+}
+
+func TestKeystoneVDown(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "keystone_v_down")
+	if err := p.KeystoneVDown(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "KeystoneVDown", projector.COMMAND_WRITE, 0x34, 0x12, 0x29, 0x00)
+}
+
+func TestKeystoneH(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xd8))
+	p := newProjector(mock, "keystone_h")
+	v, err := p.KeystoneH()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -40 {
+		t.Errorf("KeystoneH = %v, want -40", v)
+	}
+	expectFrame(t, mock, "KeystoneH", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0C)
+}
+
+func TestSetKeystoneH(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "keystone_h")
+	if err := p.SetKeystoneH(-40); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetKeystoneH", projector.COMMAND_WRITE, 0x34, 0x12, 0x0C, 0xd8)
+}
+
+func TestAutoKeystone(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "auto_keystone")
+	v, err := p.AutoKeystone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("AutoKeystone = %v, want true", v)
+	}
+	expectFrame(t, mock, "AutoKeystone", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0D)
+}
+
+func TestSetAutoKeystone(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "auto_keystone")
+	if err := p.SetAutoKeystone(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetAutoKeystone", projector.COMMAND_WRITE, 0x34, 0x12, 0x0D, 0x01)
+}
+
+func TestBrightness(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "brightness")
+	v, err := p.Brightness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("Brightness = %v, want 100", v)
+	}
+	expectFrame(t, mock, "Brightness", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x03)
+}
+
+func TestSetBrightness(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "brightness")
+	if err := p.SetBrightness(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBrightness", projector.COMMAND_WRITE, 0x34, 0x12, 0x03, 0x64)
+}
+
+func TestContrast(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "contrast")
+	v, err := p.Contrast()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("Contrast = %v, want 100", v)
+	}
+	expectFrame(t, mock, "Contrast", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x02)
+}
+
+func TestSetContrast(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "contrast")
+	if err := p.SetContrast(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetContrast", projector.COMMAND_WRITE, 0x34, 0x12, 0x02, 0x64)
+}
+
+func TestSharpness(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0f))
+	p := newProjector(mock, "sharpness")
+	v, err := p.Sharpness()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 15 {
+		t.Errorf("Sharpness = %v, want 15", v)
+	}
+	expectFrame(t, mock, "Sharpness", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0E)
+}
+
+func TestSetSharpness(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "sharpness")
+	if err := p.SetSharpness(15); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetSharpness", projector.COMMAND_WRITE, 0x34, 0x12, 0x0E, 0x0f)
+}
+
+func TestColorTemperature(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x03))
+	p := newProjector(mock, "color_temperature")
+	v, err := p.ColorTemperature()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("ColorTemperature = %v, want 3", v)
+	}
+	expectFrame(t, mock, "ColorTemperature", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x08)
+}
+
+func TestSetColorTemperature(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "color_temperature")
+	if err := p.SetColorTemperature(3); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetColorTemperature", projector.COMMAND_WRITE, 0x34, 0x12, 0x08, 0x03)
+}
+
+func TestRedGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "red_gain")
+	v, err := p.RedGain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("RedGain = %v, want 100", v)
+	}
+	expectFrame(t, mock, "RedGain", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x20)
+}
+
+func TestSetRedGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "red_gain")
+	if err := p.SetRedGain(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetRedGain", projector.COMMAND_WRITE, 0x34, 0x12, 0x20, 0x64)
+}
+
+func TestGreenGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "green_gain")
+	v, err := p.GreenGain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("GreenGain = %v, want 100", v)
+	}
+	expectFrame(t, mock, "GreenGain", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x21)
+}
+
+func TestSetGreenGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "green_gain")
+	if err := p.SetGreenGain(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetGreenGain", projector.COMMAND_WRITE, 0x34, 0x12, 0x21, 0x64)
+}
+
+func TestBlueGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "blue_gain")
+	v, err := p.BlueGain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("BlueGain = %v, want 100", v)
+	}
+	expectFrame(t, mock, "BlueGain", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x22)
+}
+
+func TestSetBlueGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "blue_gain")
+	if err := p.SetBlueGain(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBlueGain", projector.COMMAND_WRITE, 0x34, 0x12, 0x22, 0x64)
+}
+
+func TestRedOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce))
+	p := newProjector(mock, "red_offset")
+	v, err := p.RedOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("RedOffset = %v, want -50", v)
+	}
+	expectFrame(t, mock, "RedOffset", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x23)
+}
+
+func TestSetRedOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "red_offset")
+	if err := p.SetRedOffset(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetRedOffset", projector.COMMAND_WRITE, 0x34, 0x12, 0x23, 0xce)
+}
+
+func TestGreenOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce))
+	p := newProjector(mock, "green_offset")
+	v, err := p.GreenOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("GreenOffset = %v, want -50", v)
+	}
+	expectFrame(t, mock, "GreenOffset", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x24)
+}
+
+func TestSetGreenOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "green_offset")
+	if err := p.SetGreenOffset(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetGreenOffset", projector.COMMAND_WRITE, 0x34, 0x12, 0x24, 0xce)
+}
+
+func TestBlueOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce))
+	p := newProjector(mock, "blue_offset")
+	v, err := p.BlueOffset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("BlueOffset = %v, want -50", v)
+	}
+	expectFrame(t, mock, "BlueOffset", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x25)
+}
+
+func TestSetBlueOffset(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "blue_offset")
+	if err := p.SetBlueOffset(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBlueOffset", projector.COMMAND_WRITE, 0x34, 0x12, 0x25, 0xce)
+}
+
+func TestAspectRatio(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x06))
+	p := newProjector(mock, "aspect_ratio")
+	v, err := p.AspectRatio()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 6 {
+		t.Errorf("AspectRatio = %v, want 6", v)
+	}
+	expectFrame(t, mock, "AspectRatio", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x04)
+}
+
+func TestSetAspectRatio(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "aspect_ratio")
+	if err := p.SetAspectRatio(6); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetAspectRatio", projector.COMMAND_WRITE, 0x34, 0x12, 0x04, 0x06)
+}
+
+func TestColorMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x05))
+	p := newProjector(mock, "color_mode")
+	v, err := p.ColorMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 5 {
+		t.Errorf("ColorMode = %v, want 5", v)
+	}
+	expectFrame(t, mock, "ColorMode", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0B)
+}
+
+func TestSetColorMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "color_mode")
+	if err := p.SetColorMode(5); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetColorMode", projector.COMMAND_WRITE, 0x34, 0x12, 0x0B, 0x05)
+}
+
+func TestGamma(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x04))
+	p := newProjector(mock, "gamma")
+	v, err := p.Gamma()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 4 {
+		t.Errorf("Gamma = %v, want 4", v)
+	}
+	expectFrame(t, mock, "Gamma", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x0F)
+}
+
+func TestSetGamma(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "gamma")
+	if err := p.SetGamma(4); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetGamma", projector.COMMAND_WRITE, 0x34, 0x12, 0x0F, 0x04)
+}
+
+func TestHue(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce))
+	p := newProjector(mock, "hue")
+	v, err := p.Hue()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("Hue = %v, want -50", v)
+	}
+	expectFrame(t, mock, "Hue", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x10)
+}
+
+func TestSetHue(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "hue")
+	if err := p.SetHue(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetHue", projector.COMMAND_WRITE, 0x34, 0x12, 0x10, 0xce)
+}
+
+func TestSaturation(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "saturation")
+	v, err := p.Saturation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("Saturation = %v, want 100", v)
+	}
+	expectFrame(t, mock, "Saturation", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x11)
+}
+
+func TestSetSaturation(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "saturation")
+	if err := p.SetSaturation(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetSaturation", projector.COMMAND_WRITE, 0x34, 0x12, 0x11, 0x64)
+}
+
+func TestColorGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "color_gain")
+	v, err := p.ColorGain()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("ColorGain = %v, want 100", v)
+	}
+	expectFrame(t, mock, "ColorGain", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x12)
+}
+
+func TestSetColorGain(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "color_gain")
+	if err := p.SetColorGain(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetColorGain", projector.COMMAND_WRITE, 0x34, 0x12, 0x12, 0x64)
+}
+
+func TestBrilliantColor(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0a))
+	p := newProjector(mock, "brilliant_color")
+	v, err := p.BrilliantColor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 10 {
+		t.Errorf("BrilliantColor = %v, want 10", v)
+	}
+	expectFrame(t, mock, "BrilliantColor", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x13)
+}
+
+func TestSetBrilliantColor(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "brilliant_color")
+	if err := p.SetBrilliantColor(10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetBrilliantColor", projector.COMMAND_WRITE, 0x34, 0x12, 0x13, 0x0a)
+}
+
+func TestOverscan(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0a))
+	p := newProjector(mock, "overscan")
+	v, err := p.Overscan()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 10 {
+		t.Errorf("Overscan = %v, want 10", v)
+	}
+	expectFrame(t, mock, "Overscan", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x14)
+}
+
+func TestSetOverscan(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "overscan")
+	if err := p.SetOverscan(10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetOverscan", projector.COMMAND_WRITE, 0x34, 0x12, 0x14, 0x0a)
+}
+
+func TestNoiseReduction(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0a))
+	p := newProjector(mock, "noise_reduction")
+	v, err := p.NoiseReduction()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 10 {
+		t.Errorf("NoiseReduction = %v, want 10", v)
+	}
+	expectFrame(t, mock, "NoiseReduction", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x15)
+}
+
+func TestSetNoiseReduction(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "noise_reduction")
+	if err := p.SetNoiseReduction(10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetNoiseReduction", projector.COMMAND_WRITE, 0x34, 0x12, 0x15, 0x0a)
+}
+
+func TestFilmMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "film_mode")
+	v, err := p.FilmMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("FilmMode = %v, want true", v)
+	}
+	expectFrame(t, mock, "FilmMode", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x16)
+}
+
+func TestSetFilmMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "film_mode")
+	if err := p.SetFilmMode(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetFilmMode", projector.COMMAND_WRITE, 0x34, 0x12, 0x16, 0x01)
+}
+
+func TestHDMIRange(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x02))
+	p := newProjector(mock, "hdmi_range")
+	v, err := p.HDMIRange()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("HDMIRange = %v, want 2", v)
+	}
+	expectFrame(t, mock, "HDMIRange", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x17)
+}
+
+func TestSetHDMIRange(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "hdmi_range")
+	if err := p.SetHDMIRange(2); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetHDMIRange", projector.COMMAND_WRITE, 0x34, 0x12, 0x17, 0x02)
+}
+
+func TestHDMIFormat(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x02))
+	p := newProjector(mock, "hdmi_format")
+	v, err := p.HDMIFormat()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 2 {
+		t.Errorf("HDMIFormat = %v, want 2", v)
+	}
+	expectFrame(t, mock, "HDMIFormat", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x18)
+}
+
+func TestSetHDMIFormat(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "hdmi_format")
+	if err := p.SetHDMIFormat(2); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetHDMIFormat", projector.COMMAND_WRITE, 0x34, 0x12, 0x18, 0x02)
+}
+
+func TestColorSpace(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x03))
+	p := newProjector(mock, "color_space")
+	v, err := p.ColorSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("ColorSpace = %v, want 3", v)
+	}
+	expectFrame(t, mock, "ColorSpace", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x19)
+}
+
+func TestSetColorSpace(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "color_space")
+	if err := p.SetColorSpace(3); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetColorSpace", projector.COMMAND_WRITE, 0x34, 0x12, 0x19, 0x03)
+}
+
+func TestDCR(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "dcr")
+	v, err := p.DCR()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("DCR = %v, want true", v)
+	}
+	expectFrame(t, mock, "DCR", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x1A)
+}
+
+func TestSetDCR(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "dcr")
+	if err := p.SetDCR(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetDCR", projector.COMMAND_WRITE, 0x34, 0x12, 0x1A, 0x01)
+}
+
+func TestTestPattern(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x03))
+	p := newProjector(mock, "test_pattern")
+	v, err := p.TestPattern()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("TestPattern = %v, want 3", v)
+	}
+	expectFrame(t, mock, "TestPattern", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x1B)
+}
+
+func TestSetTestPattern(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "test_pattern")
+	if err := p.SetTestPattern(3); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetTestPattern", projector.COMMAND_WRITE, 0x34, 0x12, 0x1B, 0x03)
+}
+
+func TestZoomIn(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "zoom_in")
+	if err := p.ZoomIn(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "ZoomIn", projector.COMMAND_WRITE, 0x34, 0x12, 0x1D, 0x00)
+}
+
+func TestZoomOut(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "zoom_out")
+	if err := p.ZoomOut(); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "ZoomOut", projector.COMMAND_WRITE, 0x34, 0x12, 0x1E, 0x00)
+}
+
+func TestDigitalZoom(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x0a))
+	p := newProjector(mock, "digital_zoom")
+	v, err := p.DigitalZoom()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 10 {
+		t.Errorf("DigitalZoom = %v, want 10", v)
+	}
+	expectFrame(t, mock, "DigitalZoom", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x1C)
+}
+
+func TestSetDigitalZoom(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "digital_zoom")
+	if err := p.SetDigitalZoom(10); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetDigitalZoom", projector.COMMAND_WRITE, 0x34, 0x12, 0x1C, 0x0a)
+}
+
+func TestScreenColor(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x03))
+	p := newProjector(mock, "screen_color")
+	v, err := p.ScreenColor()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("ScreenColor = %v, want 3", v)
+	}
+	expectFrame(t, mock, "ScreenColor", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x1F)
+}
+
+func TestSetScreenColor(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "screen_color")
+	if err := p.SetScreenColor(3); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetScreenColor", projector.COMMAND_WRITE, 0x34, 0x12, 0x1F, 0x03)
+}
+
+func TestLampMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x03))
+	p := newProjector(mock, "lamp_mode")
+	v, err := p.LampMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 3 {
+		t.Errorf("LampMode = %v, want 3", v)
+	}
+	expectFrame(t, mock, "LampMode", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x11, 0x10)
+}
+
+func TestSetLampMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "lamp_mode")
+	if err := p.SetLampMode(3); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetLampMode", projector.COMMAND_WRITE, 0x34, 0x11, 0x10, 0x03)
+}
+
+func TestLampHours2(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x04, 0x03, 0x02, 0x01))
+	p := newProjector(mock, "lamp_hours_2")
+	v, err := p.LampHours2()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 16909060 {
+		t.Errorf("LampHours2 = %v, want 16909060", v)
+	}
+	expectFrame(t, mock, "LampHours2", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x03)
+}
+
+func TestActiveLamp(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "active_lamp")
+	v, err := p.ActiveLamp()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 1 {
+		t.Errorf("ActiveLamp = %v, want 1", v)
+	}
+	expectFrame(t, mock, "ActiveLamp", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x04)
+}
+
+func TestLightSourceHours(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x04, 0x03, 0x02, 0x01))
+	p := newProjector(mock, "light_source_hours")
+	v, err := p.LightSourceHours()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 16909060 {
+		t.Errorf("LightSourceHours = %v, want 16909060", v)
+	}
+	expectFrame(t, mock, "LightSourceHours", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x05)
+}
+
+func TestLightPowerLevel(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x64))
+	p := newProjector(mock, "light_power_level")
+	v, err := p.LightPowerLevel()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 100 {
+		t.Errorf("LightPowerLevel = %v, want 100", v)
+	}
+	expectFrame(t, mock, "LightPowerLevel", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x11, 0x11)
+}
+
+func TestSetLightPowerLevel(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "light_power_level")
+	if err := p.SetLightPowerLevel(100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetLightPowerLevel", projector.COMMAND_WRITE, 0x34, 0x11, 0x11, 0x64)
+}
+
+func TestFilterHours(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x04, 0x03, 0x02, 0x01))
+	p := newProjector(mock, "filter_hours")
+	v, err := p.FilterHours()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 16909060 {
+		t.Errorf("FilterHours = %v, want 16909060", v)
+	}
+	expectFrame(t, mock, "FilterHours", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x06)
+}
+
+func TestFilterMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x01))
+	p := newProjector(mock, "filter_mode")
+	v, err := p.FilterMode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != true {
+		t.Errorf("FilterMode = %v, want true", v)
+	}
+	expectFrame(t, mock, "FilterMode", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x15, 0x08)
+}
+
+func TestSetFilterMode(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "filter_mode")
+	if err := p.SetFilterMode(true); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetFilterMode", projector.COMMAND_WRITE, 0x34, 0x15, 0x08, 0x01)
+}
+
+func TestMode3D(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x05))
+	p := newProjector(mock, "3d_mode")
+	v, err := p.Mode3D()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != 5 {
+		t.Errorf("Mode3D = %v, want 5", v)
+	}
+	expectFrame(t, mock, "Mode3D", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x30)
+}
+
+func TestSetMode3D(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "3d_mode")
+	if err := p.SetMode3D(5); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetMode3D", projector.COMMAND_WRITE, 0x34, 0x12, 0x30, 0x05)
+}
+
+func TestLensShift(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0x9c, 0xff))
+	p := newProjector(mock, "lens_shift")
+	v, err := p.LensShift()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -100 {
+		t.Errorf("LensShift = %v, want -100", v)
+	}
+	expectFrame(t, mock, "LensShift", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x31)
+}
+
+func TestSetLensShift(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "lens_shift")
+	if err := p.SetLensShift(-100); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetLensShift", projector.COMMAND_WRITE, 0x34, 0x12, 0x31, 0x9c, 0xff)
+}
+
+func TestHPosition(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce, 0xff))
+	p := newProjector(mock, "h_position")
+	v, err := p.HPosition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("HPosition = %v, want -50", v)
+	}
+	expectFrame(t, mock, "HPosition", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x26)
+}
+
+func TestSetHPosition(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "h_position")
+	if err := p.SetHPosition(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetHPosition", projector.COMMAND_WRITE, 0x34, 0x12, 0x26, 0xce, 0xff)
+}
+
+func TestVPosition(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Response(0xce, 0xff))
+	p := newProjector(mock, "v_position")
+	v, err := p.VPosition()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v != -50 {
+		t.Errorf("VPosition = %v, want -50", v)
+	}
+	expectFrame(t, mock, "VPosition", projector.COMMAND_READ, 0x34, 0x00, 0x00, 0x12, 0x27)
+}
+
+func TestSetVPosition(t *testing.T) {
+	mock := mocktransport.New()
+	mock.Reply(mocktransport.Ack())
+	p := newProjector(mock, "v_position")
+	if err := p.SetVPosition(-50); err != nil {
+		t.Fatal(err)
+	}
+	expectFrame(t, mock, "SetVPosition", projector.COMMAND_WRITE, 0x34, 0x12, 0x27, 0xce, 0xff)
+}
@@ -0,0 +1,420 @@
+package projector
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Conn is the protocol layer: framing, checksums, retries, timeouts,
+// reconnects and the command queue, with no knowledge of what the commands
+// mean. Projector builds the command set on top of it; a Conn can be used
+// directly to drive a command set of one's own with WriteAndRead.
+//
+// Conn is safe for concurrent use by multiple goroutines: commands are
+// queued and run in FIFO order, each request/response exchange completing
+// before the next one starts, and Open, Close and Reattach wait for the
+// exchange in flight. The exported fields must be set before the Conn is
+// shared.
+type Conn struct {
+	Port Transport
+
+	// AutoReconnect, if set, reopens the port with backoff when a command
+	// fails because the connection was lost, then retries the command when
+	// that is safe.
+	AutoReconnect *ReconnectPolicy
+	// OnStateChange is called whenever the connection state changes.
+	OnStateChange func(state ConnState)
+
+	config   SerialConfig
+	backend  SerialBackend
+	usbMatch *USBMatch
+	reopen   func() (Transport, error)
+	state    atomic.Int32
+	target   byte
+	variant  ProtocolVariant
+	checksum Checksum
+
+	onSend    []Middleware
+	onReceive []Middleware
+	logger    Logger
+
+	bus          *Bus
+	retry        *RetryPolicy
+	busy         *BusyPolicy
+	pacing       Pacing
+	timeout      time.Duration
+	response     time.Duration
+	lastExchange time.Time
+	holdUntil    time.Time
+	// sent and received record the wire traffic of the current exchange
+	// for CommandError.
+	sent     []byte
+	received []byte
+	// dirty is set when an exchange fails after its request was sent, so
+	// the next one drains a late reply first.
+	dirty bool
+
+	// mu serialises exchanges and guards Port and the unexported settings.
+	mu        sync.Mutex
+	queue     commandQueue
+	drains    atomic.Uint64
+	drained   atomic.Uint64
+	lastSeen  atomic.Int64
+	alive     atomic.Bool
+	hbMu      sync.Mutex
+	heartbeat chan struct{}
+	events    chan Event
+	evMu      sync.Mutex
+	listener  chan struct{}
+}
+
+// opener captures the current settings in a function that opens portName.
+func (p *Conn) opener(portName string) func() (Transport, error) {
+	config, backend, match := p.config.withDefaults(), p.backend, p.usbMatch
+	return func() (Transport, error) {
+		name := portName
+		if name == "" && match != nil {
+			var err error
+			if name, err = FindPort(*match); err != nil {
+				return nil, err
+			}
+		}
+		return openPort(name, config, backend)
+	}
+}
+
+// attach replaces the current port with one from open, and remembers open
+// so the connection can be re-established later.
+func (p *Conn) attach(open func() (Transport, error)) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.Port != nil {
+		p.Port.Close()
+		p.Port = nil
+	}
+	p.reopen = open
+	port, err := open()
+	if err != nil {
+		p.setState(STATE_DISCONNECTED)
+		return err
+	}
+	p.Port = port
+	p.setState(STATE_CONNECTED)
+	return nil
+}
+
+// attachContext is attach for a context-aware open. An open or probe that
+// is still running when ctx is done is abandoned and its port closed.
+func (p *Conn) attachContext(ctx context.Context, open func(ctx context.Context) (Transport, error)) error {
+	p.mu.Lock()
+	if p.Port != nil {
+		p.Port.Close()
+		p.Port = nil
+	}
+	// Not remembered until the probe succeeds, so the probe itself never
+	// triggers an auto-reconnect.
+	p.reopen = nil
+	p.mu.Unlock()
+
+	type result struct {
+		port Transport
+		err  error
+	}
+	opened := make(chan result, 1)
+	go func() {
+		port, err := open(ctx)
+		opened <- result{port, err}
+	}()
+	var r result
+	select {
+	case r = <-opened:
+	case <-ctx.Done():
+		go func() {
+			if r := <-opened; r.err == nil {
+				r.port.Close()
+			}
+		}()
+		p.setState(STATE_DISCONNECTED)
+		return ctx.Err()
+	}
+	if r.err != nil {
+		p.setState(STATE_DISCONNECTED)
+		return r.err
+	}
+	p.mu.Lock()
+	p.Port = r.port
+	p.setState(STATE_CONNECTED)
+	p.mu.Unlock()
+
+	if err := p.ping(ctx); err != nil {
+		p.Close()
+		return err
+	}
+	p.mu.Lock()
+	p.reopen = func() (Transport, error) {
+		return open(context.Background())
+	}
+	p.mu.Unlock()
+	return nil
+}
+
+// Reattach swaps the underlying transport, e.g. after a USB adapter
+// re-enumerates under a new device node, while keeping the heartbeat,
+// middleware, target ID and other settings. It waits for any in-flight
+// command, then closes the old port. Auto-reconnect stays disabled until
+// the next Open or ReattachPort, since t carries no way to reopen itself.
+func (p *Conn) Reattach(t Transport) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	var err error
+	if p.Port != nil {
+		err = p.Port.Close()
+	}
+	p.Port = t
+	p.reopen = nil
+	p.setState(STATE_CONNECTED)
+	return err
+}
+
+// ReattachPort is Reattach for a port name, opened with the Conn's
+// current serial settings and remembered for auto-reconnect.
+func (p *Conn) ReattachPort(portName string) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	open := p.opener(portName)
+	port, err := open()
+	if err != nil {
+		return err
+	}
+	if p.Port != nil {
+		p.Port.Close()
+	}
+	p.Port = port
+	p.reopen = open
+	p.setState(STATE_CONNECTED)
+	return nil
+}
+
+// SetTargetID addresses subsequent commands to the projector with the given
+// ID on a daisy-chained RS-232 bus. 0 addresses every unit.
+func (p *Conn) SetTargetID(id byte) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.target = id
+}
+
+func (p *Conn) TargetID() byte {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.target
+}
+
+func (p *Conn) Close() error {
+	p.StopHeartbeat()
+	p.StopEvents()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.reopen = nil
+	if p.Port == nil {
+		return nil
+	}
+	err := p.Port.Close()
+	p.Port = nil
+	p.setState(STATE_DISCONNECTED)
+	return err
+}
+
+// Response Ref pg 74: http://www.projectorcentral.com/pdf/projector_manual_7407.pdf
+
+// ReadResponse reads one frame. Most callers want WriteAndRead instead.
+func (p *Conn) ReadResponse() (*Packet, error) {
+	var packet *Packet
+	err := p.submit(context.Background(), func(ctx context.Context) (err error) {
+		packet, err = p.readResponse(ctx)
+		return err
+	})
+	return packet, err
+}
+
+func (p *Conn) readResponse(ctx context.Context) (*Packet, error) {
+	if p.Port == nil {
+		return nil, ErrPortNotOpen
+	}
+	packet, raw, err := parseFrame(recorder{contextReader{ctx, p.Port}, &p.received}, time.Now().Add(p.responseTimeout(ctx)), p.variant, p.checksum)
+	if err == io.EOF {
+		err = ErrTimeout
+	}
+	p.trace("<", raw, packet, err)
+	if err != nil {
+		return nil, err
+	}
+	if err = runMiddleware(p.onReceive, raw, packet); err != nil {
+		return nil, err
+	}
+	return packet, nil
+}
+
+// Write sends packet without waiting for the reply. Most callers want
+// WriteAndRead instead.
+func (p *Conn) Write(packet Packet) error {
+	return p.submit(context.Background(), func(ctx context.Context) error {
+		return p.write(ctx, packet)
+	})
+}
+
+func (p *Conn) write(ctx context.Context, packet Packet) error {
+	var err error
+
+	if p.Port == nil {
+		return ErrPortNotOpen
+	}
+	if packet.ID == 0 {
+		packet.ID = p.target
+	}
+	if err = packet.Validate(); err != nil {
+		return err
+	}
+	raw := p.variant.build(packet, p.checksum)
+	if err = runMiddleware(p.onSend, raw, &packet); err != nil {
+		return err
+	}
+	p.sent = raw
+	p.trace(">", raw, &packet, nil)
+	err = p.writeRaw(ctx, raw)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (p *Conn) WriteAndRead(packet Packet) (*Packet, error) {
+	return p.WriteAndReadContext(context.Background(), packet)
+}
+
+// WriteAndReadContext is WriteAndRead bounded by ctx. Cancellation is
+// noticed between transport reads, so within one serial read timeout.
+func (p *Conn) WriteAndReadContext(ctx context.Context, packet Packet) (*Packet, error) {
+	var rPacket *Packet
+	err := p.submit(ctx, func(ctx context.Context) (err error) {
+		rPacket, err = p.roundTrip(ctx, packet)
+		return err
+	})
+	return rPacket, err
+}
+
+// roundTrip is writeAndRead with failures wrapped in a *CommandError.
+// Callers hold p.mu.
+func (p *Conn) roundTrip(ctx context.Context, packet Packet) (*Packet, error) {
+	rPacket, err := p.writeAndRead(ctx, packet)
+	if err != nil {
+		return nil, &CommandError{Request: p.sent, Response: p.received, Err: err}
+	}
+	return rPacket, nil
+}
+
+// writeAndRead runs one command on the queue worker.
+func (p *Conn) writeAndRead(ctx context.Context, packet Packet) (*Packet, error) {
+	if p.bus != nil {
+		p.bus.acquire()
+		defer p.bus.release()
+	}
+	if err := p.waitPacing(ctx); err != nil {
+		return nil, err
+	}
+	defer p.paced(ctx, packet)
+
+	rPacket, sent, err := p.exchangeBusy(ctx, packet)
+	if err == nil || p.AutoReconnect == nil || p.reopen == nil || !isConnectionError(err) {
+		return rPacket, err
+	}
+
+	p.setState(STATE_DISCONNECTED)
+	if rerr := p.reconnect(ctx); rerr != nil {
+		return nil, err
+	}
+	// Reads are always safe to repeat; a write is only repeated if it never
+	// made it onto the wire.
+	if sent && packet.Command != COMMAND_READ {
+		return nil, err
+	}
+	rPacket, _, err = p.exchangeBusy(ctx, packet)
+	return rPacket, err
+}
+
+// exchange performs one request/response round trip. sent reports whether
+// the packet was written before the failure.
+func (p *Conn) exchange(ctx context.Context, packet Packet) (*Packet, bool, error) {
+	p.sent, p.received = nil, nil
+	if p.Port == nil {
+		return nil, false, ErrPortNotOpen
+	}
+
+	p.pollEvents(ctx)
+	err := p.Port.Flush()
+	if err != nil {
+		return nil, false, err
+	}
+	if err = p.drain(ctx); err != nil {
+		return nil, false, err
+	}
+
+	err = p.write(ctx, packet)
+	if err != nil {
+		return nil, false, err
+	}
+
+	rPacket, err := p.readReply(ctx, packet)
+	if err != nil {
+		p.dirty = true
+		return nil, true, err
+	}
+
+	if rPacket.Command == COMMAND_EXCEPTION {
+		return nil, true, newExceptionError(rPacket)
+	}
+	return rPacket, true, nil
+}
+
+// recorder appends everything read to *buf.
+type recorder struct {
+	r   io.Reader
+	buf *[]byte
+}
+
+func (r recorder) Read(b []byte) (int, error) {
+	n, err := r.r.Read(b)
+	*r.buf = append(*r.buf, b[:n]...)
+	return n, err
+}
+
+// contextReader fails reads once ctx is done, so a frame being read is
+// abandoned promptly on a serial port that returns at each read timeout.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c contextReader) Read(b []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
+	}
+	return c.r.Read(b)
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,16 @@
+package projector
+
+import "github.com/echo1001/go-viewsonic/pjlink"
+
+// Controller is the high-level API shared by the RS-232 Projector and the
+// PJLink client, so callers can switch between the two transparently.
+type Controller interface {
+	PowerState() (bool, error)
+	PowerOn() error
+	PowerOff() error
+	LampHours() (uint32, error)
+	Close() error
+}
+
+var _ Controller = (*Projector)(nil)
+var _ Controller = (*pjlink.Client)(nil)
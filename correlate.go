@@ -0,0 +1,74 @@
+package projector
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+)
+
+// maxStaleReplies is how many replies that do not belong to the request an
+// exchange discards before giving up.
+const maxStaleReplies = 2
+
+// MismatchError is returned when the reply is addressed to another
+// projector or echoes a different function than the request.
+type MismatchError struct {
+	Request Packet
+	Packet  *Packet
+}
+
+func (e *MismatchError) Error() string {
+	return fmt.Sprintf("Reply does not match request: sent [% x] to id %d, got [% x] from id %d",
+		e.Request.Data, e.Request.ID, e.Packet.Data, e.Packet.ID)
+}
+
+// correlate checks that reply answers request. Replies normally carry no
+// copy of the request, only the projector ID; firmware that echoes the
+// function bytes ahead of the value (34 <opcode> instead of the 00 00
+// status bytes) is checked against the opcode sent, and the echo is
+// rewritten to the usual form so Packet.Value works unchanged.
+func correlate(request Packet, reply *Packet) error {
+	if request.ID != 0 && reply.ID != 0 && reply.ID != request.ID {
+		return &MismatchError{Request: request, Packet: reply}
+	}
+	if reply.Command == COMMAND_EXCEPTION {
+		return nil
+	}
+	if expected, ok := expectedReply(request.Command); ok && reply.Command != expected {
+		return &ProtocolError{Expected: expected, Packet: reply}
+	}
+	if reply.Command == COMMAND_RESPONSE && len(reply.Data) >= 3 && reply.Data[0] == 0x34 {
+		if len(request.Data) < 5 || !bytes.Equal(reply.Data[1:3], request.Data[3:5]) {
+			return &MismatchError{Request: request, Packet: reply}
+		}
+		reply.Data = append([]byte{0x00, 0x00}, reply.Data[3:]...)
+	}
+	return nil
+}
+
+// readReply reads until a frame that answers request arrives, skipping up to
+// maxStaleReplies left over from earlier exchanges or sent unsolicited,
+// which are delivered as events. If no matching frame
+// follows a stale one, the mismatch is returned.
+func (p *Conn) readReply(ctx context.Context, request Packet) (*Packet, error) {
+	if request.ID == 0 {
+		request.ID = p.target
+	}
+	reply, err := p.readResponse(ctx)
+	for stale := 0; err == nil; stale++ {
+		// Any frame, even a stale one, shows the projector is there.
+		p.markSeen()
+		mismatch := correlate(request, reply)
+		if mismatch == nil {
+			return reply, nil
+		}
+		p.dispatch(reply)
+		if stale == maxStaleReplies {
+			return nil, mismatch
+		}
+		if reply, err = p.readResponse(ctx); err != nil {
+			return nil, mismatch
+		}
+	}
+	return nil, err
+}
@@ -0,0 +1,34 @@
+package projector
+
+// Discover probes every serial port on the host with a power state query
+// and returns the names of the ports where a projector answered. Ports that
+// cannot be opened, e.g. because they are in use, are skipped. With
+// WithUSBMatch only matching adapters are probed.
+func Discover(opts ...Option) ([]string, error) {
+	ports, err := ListPorts()
+	if err != nil {
+		return nil, err
+	}
+	settings := &Projector{}
+	settings.apply(opts)
+	var found []string
+	for _, port := range ports {
+		if settings.usbMatch != nil && !settings.usbMatch.matches(port) {
+			continue
+		}
+		if probePort(port.Name, opts) {
+			found = append(found, port.Name)
+		}
+	}
+	return found, nil
+}
+
+func probePort(name string, opts []Option) bool {
+	p := &Projector{}
+	if err := p.Open(name, opts...); err != nil {
+		return false
+	}
+	defer p.Close()
+	_, err := p.PowerState()
+	return err == nil
+}
@@ -0,0 +1,87 @@
+package projector
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"time"
+)
+
+// maxDrainBytes caps how much input one drain discards, so a port that
+// never stops talking cannot hold up the next command forever.
+const maxDrainBytes = 256
+
+// DrainStats counts the input discarded ahead of exchanges.
+type DrainStats struct {
+	// Drains is how many exchanges found stale input to discard.
+	Drains uint64
+	// Bytes is the total discarded.
+	Bytes uint64
+}
+
+// DrainStats reports how often stale input has been drained. Flush empties
+// the transport's buffer before every exchange, but the tail of a reply
+// that timed out may still be in flight, so after an exchange fails, or
+// whenever the transport reports input waiting, the next one first reads
+// and discards input until the port goes quiet. Complete frames found are
+// delivered as events.
+func (p *Conn) DrainStats() DrainStats {
+	return DrainStats{Drains: p.drains.Load(), Bytes: p.drained.Load()}
+}
+
+// bufferedTransport is implemented by transports that can tell without
+// blocking whether input is waiting.
+type bufferedTransport interface {
+	// Buffered is non-zero if input is waiting to be read.
+	Buffered() int
+}
+
+// drain discards pending input, up to maxDrainBytes, if the last exchange
+// failed or the transport has input waiting. A read timeout means the line
+// is quiet; other read errors are returned. Callers hold p.mu.
+func (p *Conn) drain(ctx context.Context) error {
+	if b, ok := p.Port.(bufferedTransport); !p.dirty && (!ok || b.Buffered() == 0) {
+		return nil
+	}
+	p.dirty = false
+	r := contextReader{ctx, p.Port}
+	buf := make([]byte, 64)
+	var stale []byte
+	defer func() {
+		if len(stale) > 0 {
+			p.drains.Add(1)
+			p.drained.Add(uint64(len(stale)))
+			p.deliverStale(stale)
+		}
+	}()
+	for len(stale) < maxDrainBytes {
+		n, err := r.Read(buf)
+		stale = append(stale, buf[:n]...)
+		if err == io.EOF || isTimeout(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+// deliverStale delivers the complete frames in drained input as events.
+// Callers hold p.mu.
+func (p *Conn) deliverStale(stale []byte) {
+	if p.events == nil {
+		return
+	}
+	r := bytes.NewReader(stale)
+	for r.Len() > 0 {
+		packet, raw, err := parseFrame(r, time.Time{}, p.variant, p.checksum)
+		if err != nil {
+			return
+		}
+		p.deliver(raw, packet)
+	}
+}
@@ -0,0 +1,333 @@
+package projector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Enumerated settings are named by tables mapping each value to the name
+// shown in the OSD. Parsing ignores case, spaces, dashes and underscores.
+
+func enumString[T ~uint8](names map[T]string, v T) string {
+	if name, ok := names[v]; ok {
+		return name
+	}
+	return fmt.Sprintf("0x%02x", uint8(v))
+}
+
+func parseEnum[T ~uint8](names map[T]string, kind, s string) (T, error) {
+	key := enumKey(s)
+	for v, name := range names {
+		if enumKey(name) == key {
+			return v, nil
+		}
+	}
+	return 0, ProjectorError(fmt.Sprintf("Unknown %s %q", kind, s))
+}
+
+// enumValues returns the values names defines, in order, for
+// Command.Values.
+func enumValues[T ~uint8](names map[T]string) []int {
+	var values []int
+	for v := range names {
+		values = append(values, int(v))
+	}
+	sort.Ints(values)
+	return values
+}
+
+func enumKey(s string) string {
+	return strings.ToLower(strings.NewReplacer(" ", "", "-", "", "_", "").Replace(s))
+}
+
+// Source is a video input.
+type Source uint8
+
+const SOURCE_VGA1 Source = 0x00
+const SOURCE_HDMI1 Source = 0x03
+const SOURCE_COMPOSITE Source = 0x05
+const SOURCE_SVIDEO Source = 0x06
+const SOURCE_HDMI2 Source = 0x07
+const SOURCE_VGA2 Source = 0x08
+const SOURCE_USBC Source = 0x0f
+
+var sourceNames = map[Source]string{
+	SOURCE_VGA1:      "VGA1",
+	SOURCE_HDMI1:     "HDMI1",
+	SOURCE_COMPOSITE: "Composite",
+	SOURCE_SVIDEO:    "S-Video",
+	SOURCE_HDMI2:     "HDMI2",
+	SOURCE_VGA2:      "VGA2",
+	SOURCE_USBC:      "USB-C",
+}
+
+func (s Source) String() string {
+	return enumString(sourceNames, s)
+}
+
+// ParseSource returns the source named s, e.g. "HDMI1" or "svideo".
+func ParseSource(s string) (Source, error) {
+	return parseEnum(sourceNames, "source", s)
+}
+
+// AudioSource is where the audio is taken from, independently of the video
+// input on models that allow it.
+type AudioSource uint8
+
+const AUDIO_SOURCE_IN1 AudioSource = 0x00
+const AUDIO_SOURCE_IN2 AudioSource = 0x01
+const AUDIO_SOURCE_HDMI AudioSource = 0x02
+
+var audioSourceNames = map[AudioSource]string{
+	AUDIO_SOURCE_IN1:  "Audio In 1",
+	AUDIO_SOURCE_IN2:  "Audio In 2",
+	AUDIO_SOURCE_HDMI: "HDMI",
+}
+
+func (s AudioSource) String() string {
+	return enumString(audioSourceNames, s)
+}
+
+func ParseAudioSource(s string) (AudioSource, error) {
+	return parseEnum(audioSourceNames, "audio source", s)
+}
+
+// ColorTemperature is a white point preset. Models offer a subset.
+type ColorTemperature uint8
+
+const COLOR_TEMPERATURE_WARM ColorTemperature = 0x00
+const COLOR_TEMPERATURE_NORMAL ColorTemperature = 0x01
+const COLOR_TEMPERATURE_NEUTRAL ColorTemperature = 0x02
+const COLOR_TEMPERATURE_COOL ColorTemperature = 0x03
+
+var colorTemperatureNames = map[ColorTemperature]string{
+	COLOR_TEMPERATURE_WARM:    "Warm",
+	COLOR_TEMPERATURE_NORMAL:  "Normal",
+	COLOR_TEMPERATURE_NEUTRAL: "Neutral",
+	COLOR_TEMPERATURE_COOL:    "Cool",
+}
+
+func (t ColorTemperature) String() string {
+	return enumString(colorTemperatureNames, t)
+}
+
+func ParseColorTemperature(s string) (ColorTemperature, error) {
+	return parseEnum(colorTemperatureNames, "color temperature", s)
+}
+
+// AspectRatio is how the source image is scaled to the panel.
+type AspectRatio uint8
+
+const ASPECT_AUTO AspectRatio = 0x00
+const ASPECT_4_3 AspectRatio = 0x02
+const ASPECT_16_9 AspectRatio = 0x03
+const ASPECT_16_10 AspectRatio = 0x04
+const ASPECT_NATIVE AspectRatio = 0x05
+const ASPECT_ANAMORPHIC AspectRatio = 0x06
+
+var aspectRatioNames = map[AspectRatio]string{
+	ASPECT_AUTO:       "Auto",
+	ASPECT_4_3:        "4:3",
+	ASPECT_16_9:       "16:9",
+	ASPECT_16_10:      "16:10",
+	ASPECT_NATIVE:     "Native",
+	ASPECT_ANAMORPHIC: "Anamorphic",
+}
+
+func (a AspectRatio) String() string {
+	return enumString(aspectRatioNames, a)
+}
+
+func ParseAspectRatio(s string) (AspectRatio, error) {
+	return parseEnum(aspectRatioNames, "aspect ratio", s)
+}
+
+// ColorMode is a picture mode preset.
+type ColorMode uint8
+
+const COLOR_MODE_BRIGHTEST ColorMode = 0x00
+const COLOR_MODE_DYNAMIC ColorMode = 0x01
+const COLOR_MODE_STANDARD ColorMode = 0x02
+const COLOR_MODE_MOVIE ColorMode = 0x03
+const COLOR_MODE_SRGB ColorMode = 0x04
+const COLOR_MODE_USER ColorMode = 0x05
+
+var colorModeNames = map[ColorMode]string{
+	COLOR_MODE_BRIGHTEST: "Brightest",
+	COLOR_MODE_DYNAMIC:   "Dynamic",
+	COLOR_MODE_STANDARD:  "Standard",
+	COLOR_MODE_MOVIE:     "Movie",
+	COLOR_MODE_SRGB:      "sRGB",
+	COLOR_MODE_USER:      "User",
+}
+
+func (m ColorMode) String() string {
+	return enumString(colorModeNames, m)
+}
+
+func ParseColorMode(s string) (ColorMode, error) {
+	return parseEnum(colorModeNames, "color mode", s)
+}
+
+// Gamma is a gamma curve. GAMMA_2_6 is only offered by the PX series.
+type Gamma uint8
+
+const GAMMA_1_8 Gamma = 0x00
+const GAMMA_2_0 Gamma = 0x01
+const GAMMA_2_2 Gamma = 0x02
+const GAMMA_2_35 Gamma = 0x03
+const GAMMA_2_5 Gamma = 0x04
+const GAMMA_2_6 Gamma = 0x05
+
+var gammaNames = map[Gamma]string{
+	GAMMA_1_8:  "1.8",
+	GAMMA_2_0:  "2.0",
+	GAMMA_2_2:  "2.2",
+	GAMMA_2_35: "2.35",
+	GAMMA_2_5:  "2.5",
+	GAMMA_2_6:  "2.6",
+}
+
+func (g Gamma) String() string {
+	return enumString(gammaNames, g)
+}
+
+func ParseGamma(s string) (Gamma, error) {
+	return parseEnum(gammaNames, "gamma", s)
+}
+
+// HDMIRange is the RGB level range expected on HDMI. A mismatch with the
+// source crushes blacks or washes them out.
+type HDMIRange uint8
+
+const HDMI_RANGE_AUTO HDMIRange = 0x00
+const HDMI_RANGE_FULL HDMIRange = 0x01
+const HDMI_RANGE_LIMITED HDMIRange = 0x02
+
+var hdmiRangeNames = map[HDMIRange]string{
+	HDMI_RANGE_AUTO:    "Auto",
+	HDMI_RANGE_FULL:    "Full",
+	HDMI_RANGE_LIMITED: "Limited",
+}
+
+func (r HDMIRange) String() string {
+	return enumString(hdmiRangeNames, r)
+}
+
+func ParseHDMIRange(s string) (HDMIRange, error) {
+	return parseEnum(hdmiRangeNames, "HDMI range", s)
+}
+
+// HDMIFormat is the signal format expected on HDMI, on the HD models.
+type HDMIFormat uint8
+
+const HDMI_FORMAT_AUTO HDMIFormat = 0x00
+const HDMI_FORMAT_RGB HDMIFormat = 0x01
+const HDMI_FORMAT_YUV HDMIFormat = 0x02
+
+var hdmiFormatNames = map[HDMIFormat]string{
+	HDMI_FORMAT_AUTO: "Auto",
+	HDMI_FORMAT_RGB:  "RGB",
+	HDMI_FORMAT_YUV:  "YUV",
+}
+
+func (f HDMIFormat) String() string {
+	return enumString(hdmiFormatNames, f)
+}
+
+func ParseHDMIFormat(s string) (HDMIFormat, error) {
+	return parseEnum(hdmiFormatNames, "HDMI format", s)
+}
+
+// ColorSpace is how the input signal's color is interpreted.
+type ColorSpace uint8
+
+const COLOR_SPACE_AUTO ColorSpace = 0x00
+const COLOR_SPACE_RGB ColorSpace = 0x01
+const COLOR_SPACE_YUV444 ColorSpace = 0x02
+const COLOR_SPACE_YUV422 ColorSpace = 0x03
+
+var colorSpaceNames = map[ColorSpace]string{
+	COLOR_SPACE_AUTO:   "Auto",
+	COLOR_SPACE_RGB:    "RGB",
+	COLOR_SPACE_YUV444: "YUV 4:4:4",
+	COLOR_SPACE_YUV422: "YUV 4:2:2",
+}
+
+func (c ColorSpace) String() string {
+	return enumString(colorSpaceNames, c)
+}
+
+func ParseColorSpace(s string) (ColorSpace, error) {
+	return parseEnum(colorSpaceNames, "color space", s)
+}
+
+// TestPattern is a built-in pattern for aligning and focusing.
+type TestPattern uint8
+
+const TEST_PATTERN_OFF TestPattern = 0x00
+const TEST_PATTERN_GRID TestPattern = 0x01
+const TEST_PATTERN_CROSSHATCH TestPattern = 0x02
+const TEST_PATTERN_WHITE TestPattern = 0x03
+
+var testPatternNames = map[TestPattern]string{
+	TEST_PATTERN_OFF:        "Off",
+	TEST_PATTERN_GRID:       "Grid",
+	TEST_PATTERN_CROSSHATCH: "Crosshatch",
+	TEST_PATTERN_WHITE:      "White",
+}
+
+func (t TestPattern) String() string {
+	return enumString(testPatternNames, t)
+}
+
+func ParseTestPattern(s string) (TestPattern, error) {
+	return parseEnum(testPatternNames, "test pattern", s)
+}
+
+// ScreenColor compensates for projecting onto a colored surface.
+type ScreenColor uint8
+
+const SCREEN_COLOR_OFF ScreenColor = 0x00
+const SCREEN_COLOR_BLACKBOARD ScreenColor = 0x01
+const SCREEN_COLOR_GREENBOARD ScreenColor = 0x02
+const SCREEN_COLOR_WHITEBOARD ScreenColor = 0x03
+
+var screenColorNames = map[ScreenColor]string{
+	SCREEN_COLOR_OFF:        "Off",
+	SCREEN_COLOR_BLACKBOARD: "Blackboard",
+	SCREEN_COLOR_GREENBOARD: "Greenboard",
+	SCREEN_COLOR_WHITEBOARD: "Whiteboard",
+}
+
+func (c ScreenColor) String() string {
+	return enumString(screenColorNames, c)
+}
+
+func ParseScreenColor(s string) (ScreenColor, error) {
+	return parseEnum(screenColorNames, "screen color", s)
+}
+
+// LampMode trades brightness for lamp life and power draw.
+type LampMode uint8
+
+const LAMP_MODE_NORMAL LampMode = 0x00
+const LAMP_MODE_ECO LampMode = 0x01
+const LAMP_MODE_DYNAMIC LampMode = 0x02
+const LAMP_MODE_SUPER_ECO LampMode = 0x03
+
+var lampModeNames = map[LampMode]string{
+	LAMP_MODE_NORMAL:    "Normal",
+	LAMP_MODE_ECO:       "Eco",
+	LAMP_MODE_DYNAMIC:   "Dynamic",
+	LAMP_MODE_SUPER_ECO: "SuperEco",
+}
+
+func (m LampMode) String() string {
+	return enumString(lampModeNames, m)
+}
+
+func ParseLampMode(s string) (LampMode, error) {
+	return parseEnum(lampModeNames, "lamp mode", s)
+}
@@ -0,0 +1,106 @@
+package projector
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Error classes. Failures carrying more detail wrap the class so callers can
+// test with errors.Is and extract the detail with errors.As: an exception
+// reply is an *ExceptionError and a bad checksum a *ChecksumError.
+const ErrPortNotOpen = ProjectorError("Port not open")
+const ErrChecksum = ProjectorError("Checksum failed")
+const ErrTimeout = ProjectorError("No response from projector")
+const ErrException = ProjectorError("Projector returned exception")
+const ErrUnsupported = ProjectorError("Command not supported by model")
+
+// ErrConnectionClosed is returned by network transports once the peer has
+// closed the connection, where a serial port would only time out.
+const ErrConnectionClosed = ProjectorError("Connection closed by peer")
+
+// ErrTruncatedResponse is returned when a frame stops arriving part way
+// through.
+const ErrTruncatedResponse = ProjectorError("Truncated response")
+
+// ErrShortValue is returned when a response carries fewer value bytes than
+// the command's type needs.
+const ErrShortValue = ProjectorError("Response value too short")
+
+// ErrOutOfRange is returned, wrapped in a *RangeError, for a value outside
+// a command's documented range. Nothing is sent.
+const ErrOutOfRange = ProjectorError("Value out of range")
+
+type RangeError struct {
+	Command  string
+	Value    int
+	Min, Max int
+	// Values is set when the value is not one of an enum's defined values.
+	Values []int
+}
+
+func (e *RangeError) Error() string {
+	if e.Values != nil {
+		return fmt.Sprintf("Value out of range: got %d, want one of %v", e.Value, e.Values)
+	}
+	return fmt.Sprintf("Value out of range: got %d, want %d to %d", e.Value, e.Min, e.Max)
+}
+
+func (e *RangeError) Is(target error) bool {
+	return target == ErrOutOfRange
+}
+
+// ChecksumError is a frame whose trailing byte did not match its contents.
+type ChecksumError struct {
+	Packet *Packet
+	Got    byte
+	Want   byte
+}
+
+func (e *ChecksumError) Error() string {
+	return fmt.Sprintf("Checksum failed: got 0x%02x, want 0x%02x", e.Got, e.Want)
+}
+
+func (e *ChecksumError) Is(target error) bool {
+	return target == ErrChecksum
+}
+
+// CommandError adds what was on the wire to a failed command. It unwraps to
+// the underlying error.
+type CommandError struct {
+	// Command is the registry name, or empty for a raw packet.
+	Command string
+	// Request is the last frame sent, nil if nothing was written.
+	Request []byte
+	// Response is every byte received for the request, which may end in a
+	// partial frame.
+	Response []byte
+	Err      error
+}
+
+func (e *CommandError) Error() string {
+	name := e.Command
+	if name == "" {
+		name = "command"
+	}
+	if e.Request == nil {
+		return fmt.Sprintf("%s: %v", name, e.Err)
+	}
+	return fmt.Sprintf("%s [% x]: %v (received [% x])", name, e.Request, e.Err, e.Response)
+}
+
+func (e *CommandError) Unwrap() error {
+	return e.Err
+}
+
+// withCommand names the command in err.
+func withCommand(err error, name string) error {
+	if err == nil {
+		return nil
+	}
+	var cmdErr *CommandError
+	if errors.As(err, &cmdErr) {
+		cmdErr.Command = name
+		return err
+	}
+	return &CommandError{Command: name, Err: err}
+}
@@ -0,0 +1,113 @@
+package projector
+
+import (
+	"context"
+	"time"
+)
+
+// eventBuffer is how many events are held for a slow reader before new ones
+// are dropped.
+const eventBuffer = 16
+
+// maxEventsPerPoll bounds how many frames one poll reads, so a chattering
+// projector cannot starve queued commands.
+const maxEventsPerPoll = 8
+
+// Event is a frame the projector sent on its own, such as a status report
+// on a power transition, rather than in reply to a command.
+type Event struct {
+	Packet   *Packet
+	Received time.Time
+}
+
+// StartEvents listens for unsolicited frames and delivers them on the
+// returned channel. The port is polled every interval while no command is
+// running, and frames found ahead of a reply or that do not belong to it are
+// delivered too instead of being discarded. Events are dropped while the
+// channel is full. The channel is closed by StopEvents or Close.
+func (p *Conn) StartEvents(interval time.Duration) <-chan Event {
+	p.StopEvents()
+	events := make(chan Event, eventBuffer)
+	stop := make(chan struct{})
+	p.mu.Lock()
+	p.events = events
+	p.mu.Unlock()
+	p.evMu.Lock()
+	p.listener = stop
+	p.evMu.Unlock()
+	go p.runEvents(interval, stop)
+	return events
+}
+
+func (p *Conn) StopEvents() {
+	p.evMu.Lock()
+	if p.listener != nil {
+		close(p.listener)
+		p.listener = nil
+	}
+	p.evMu.Unlock()
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.events != nil {
+		close(p.events)
+		p.events = nil
+	}
+}
+
+func (p *Conn) runEvents(interval time.Duration, stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		p.submit(context.Background(), func(ctx context.Context) error {
+			// On a Bus the line is shared, so it is taken as for a command.
+			if p.bus != nil {
+				p.bus.acquire()
+				defer p.bus.release()
+			}
+			p.pollEvents(ctx)
+			return nil
+		})
+	}
+}
+
+// pollEvents delivers whatever complete frames are waiting on the port. It
+// gives up at the first read that returns nothing. Callers hold p.mu.
+func (p *Conn) pollEvents(ctx context.Context) {
+	if p.events == nil || p.Port == nil {
+		return
+	}
+	for i := 0; i < maxEventsPerPoll; i++ {
+		packet, raw, err := parseFrame(contextReader{ctx, p.Port}, time.Time{}, p.variant, p.checksum)
+		if err != nil {
+			return
+		}
+		p.deliver(raw, packet)
+	}
+}
+
+// deliver traces an unsolicited frame and dispatches it. Callers hold p.mu.
+func (p *Conn) deliver(raw []byte, packet *Packet) {
+	p.trace("<", raw, packet, nil)
+	if runMiddleware(p.onReceive, raw, packet) != nil {
+		return
+	}
+	p.markSeen()
+	p.dispatch(packet)
+}
+
+// dispatch delivers packet as an event if anyone is listening. Callers hold
+// p.mu.
+func (p *Conn) dispatch(packet *Packet) {
+	if p.events == nil {
+		return
+	}
+	select {
+	case p.events <- Event{Packet: packet, Received: time.Now()}:
+	default:
+	}
+}
@@ -0,0 +1,63 @@
+package projector
+
+import "fmt"
+
+type ExceptionCode byte
+
+const EXCEPTION_UNSPECIFIED ExceptionCode = 0x00
+const EXCEPTION_UNSUPPORTED ExceptionCode = 0x01
+const EXCEPTION_INVALID_PARAMETER ExceptionCode = 0x02
+const EXCEPTION_BUSY ExceptionCode = 0x03
+const EXCEPTION_UNAVAILABLE ExceptionCode = 0x04
+const EXCEPTION_CHECKSUM ExceptionCode = 0x05
+
+func (c ExceptionCode) String() string {
+	switch c {
+	case EXCEPTION_UNSPECIFIED:
+		return "unspecified"
+	case EXCEPTION_UNSUPPORTED:
+		return "unsupported command"
+	case EXCEPTION_INVALID_PARAMETER:
+		return "invalid parameter"
+	case EXCEPTION_BUSY:
+		return "busy"
+	case EXCEPTION_UNAVAILABLE:
+		return "not available in current state"
+	case EXCEPTION_CHECKSUM:
+		return "checksum error"
+	}
+	return "unknown"
+}
+
+// ExceptionError is returned when the projector answers with an exception
+// packet. Code is taken from the first payload byte. Besides ErrException
+// it matches ErrBusy or ErrUnsupported for those codes.
+type ExceptionError struct {
+	Code   ExceptionCode
+	Data   []byte
+	Packet *Packet
+}
+
+func newExceptionError(packet *Packet) *ExceptionError {
+	e := &ExceptionError{Data: packet.Data, Packet: packet}
+	if len(packet.Data) > 0 {
+		e.Code = ExceptionCode(packet.Data[0])
+	}
+	return e
+}
+
+func (e *ExceptionError) Error() string {
+	return fmt.Sprintf("Projector returned exception: %s (0x%02x)", e.Code, byte(e.Code))
+}
+
+func (e *ExceptionError) Is(target error) bool {
+	switch target {
+	case ErrException:
+		return true
+	case ErrBusy:
+		return e.Code == EXCEPTION_BUSY
+	case ErrUnsupported:
+		return e.Code == EXCEPTION_UNSUPPORTED
+	}
+	return false
+}
@@ -0,0 +1,155 @@
+package projector
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+type FailoverPath int
+
+const PATH_PRIMARY FailoverPath = 0
+const PATH_SECONDARY FailoverPath = 1
+
+func (f FailoverPath) String() string {
+	if f == PATH_PRIMARY {
+		return "primary"
+	}
+	return "secondary"
+}
+
+const DefaultFailbackInterval = time.Second * 30
+
+// FailoverTransport prefers its primary path, typically the LAN, and
+// switches to the secondary, typically RS-232, when the primary fails. While
+// on the secondary it retries the primary every FailbackInterval, checked
+// at the start of each exchange.
+type FailoverTransport struct {
+	// OnSwitch, if set, is called with the newly active path.
+	OnSwitch func(path FailoverPath)
+	// FailbackInterval defaults to DefaultFailbackInterval.
+	FailbackInterval time.Duration
+
+	open        [2]func() (Transport, error)
+	mu          sync.Mutex
+	active      Transport
+	path        FailoverPath
+	lastAttempt time.Time
+}
+
+// NewFailoverTransport opens whichever path is available, primary first.
+func NewFailoverTransport(primary, secondary func() (Transport, error)) (*FailoverTransport, error) {
+	t := &FailoverTransport{open: [2]func() (Transport, error){primary, secondary}}
+	if err := t.switchTo(PATH_PRIMARY); err != nil {
+		if err = t.switchTo(PATH_SECONDARY); err != nil {
+			return nil, err
+		}
+	}
+	return t, nil
+}
+
+// DialFailover controls a projector over its LAN port at addr, falling back
+// to the serial port portName when the network path is unavailable.
+func DialFailover(addr, portName string, onSwitch func(path FailoverPath), opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	config, backend := p.SerialConfig(), p.backend
+	err := p.attach(func() (Transport, error) {
+		t, err := NewFailoverTransport(func() (Transport, error) {
+			return dialTCPTransport(addr, DefaultConnectTimeout, config.ReadTimeout)
+		}, func() (Transport, error) {
+			return openPort(portName, config, backend)
+		})
+		if err != nil {
+			return nil, err
+		}
+		t.OnSwitch = onSwitch
+		if onSwitch != nil {
+			onSwitch(t.Path())
+		}
+		return t, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Path returns the active path.
+func (t *FailoverTransport) Path() FailoverPath {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.path
+}
+
+func (t *FailoverTransport) switchTo(path FailoverPath) error {
+	t.lastAttempt = time.Now()
+	port, err := t.open[path]()
+	if err != nil {
+		return err
+	}
+	if t.active != nil {
+		t.active.Close()
+	}
+	changed := t.active != nil && t.path != path
+	t.active, t.path = port, path
+	if changed && t.OnSwitch != nil {
+		t.OnSwitch(path)
+	}
+	return nil
+}
+
+// fail abandons the active path after an error and switches to the other.
+func (t *FailoverTransport) fail() error {
+	other := PATH_SECONDARY
+	if t.path == PATH_SECONDARY {
+		other = PATH_PRIMARY
+	}
+	return t.switchTo(other)
+}
+
+func (t *FailoverTransport) Read(b []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n, err := t.active.Read(b)
+	if err != nil && err != io.EOF {
+		// The reply is lost either way; switch so the retry has a path.
+		t.fail()
+	}
+	return n, err
+}
+
+func (t *FailoverTransport) Write(b []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	n, err := t.active.Write(b)
+	if err != nil && n == 0 {
+		if t.fail() == nil {
+			n, err = t.active.Write(b)
+		}
+	}
+	return n, err
+}
+
+func (t *FailoverTransport) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	interval := t.FailbackInterval
+	if interval <= 0 {
+		interval = DefaultFailbackInterval
+	}
+	if t.path == PATH_SECONDARY && time.Since(t.lastAttempt) >= interval {
+		t.switchTo(PATH_PRIMARY)
+	}
+	err := t.active.Flush()
+	if err != nil && t.fail() == nil {
+		err = t.active.Flush()
+	}
+	return err
+}
+
+func (t *FailoverTransport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.active.Close()
+}
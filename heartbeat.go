@@ -0,0 +1,90 @@
+package projector
+
+import (
+	"context"
+	"time"
+)
+
+const heartbeatMaxMisses = 2
+
+// LastSeen returns when the projector last answered any command, or the
+// zero time if it never has.
+func (p *Conn) LastSeen() time.Time {
+	nanos := p.lastSeen.Load()
+	if nanos == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, nanos)
+}
+
+// IsAlive reports whether the projector answered its most recent command
+// and has not since been declared dead by the heartbeat.
+func (p *Conn) IsAlive() bool {
+	return p.alive.Load()
+}
+
+func (p *Conn) markSeen() {
+	p.lastSeen.Store(time.Now().UnixNano())
+	p.alive.Store(true)
+}
+
+// StartHeartbeat polls the power state every interval whenever no other
+// command has reached the projector in that time. After consecutive missed
+// polls the projector is marked dead and onDead, if set, is called with the
+// last error. onDead fires once per outage.
+func (p *Conn) StartHeartbeat(interval time.Duration, onDead func(err error)) {
+	p.StopHeartbeat()
+	stop := make(chan struct{})
+	p.hbMu.Lock()
+	p.heartbeat = stop
+	p.hbMu.Unlock()
+	go p.runHeartbeat(interval, onDead, stop)
+}
+
+func (p *Conn) StopHeartbeat() {
+	p.hbMu.Lock()
+	defer p.hbMu.Unlock()
+	if p.heartbeat != nil {
+		close(p.heartbeat)
+		p.heartbeat = nil
+	}
+}
+
+func (p *Conn) runHeartbeat(interval time.Duration, onDead func(err error), stop chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	misses := 0
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+		if time.Since(p.LastSeen()) < interval {
+			misses = 0
+			continue
+		}
+		start := time.Now()
+		err := p.ping(context.Background())
+		// Any reply, even an exception, proves the link is up.
+		if !p.LastSeen().Before(start) {
+			misses = 0
+			continue
+		}
+		misses++
+		if misses >= heartbeatMaxMisses && p.alive.Swap(false) && onDead != nil {
+			onDead(err)
+		}
+	}
+}
+
+// ping queries the power state, which every model answers.
+func (p *Conn) ping(ctx context.Context) error {
+	c, _ := LookupCommand("power")
+	packet, err := c.ReadPacket()
+	if err != nil {
+		return err
+	}
+	_, err = p.WriteAndReadContext(ctx, packet)
+	return err
+}
@@ -0,0 +1,29 @@
+package projector
+
+import "context"
+
+// ErrNotConfirmed is returned for irreversible commands sent without force,
+// or through Exec or Batch.
+const ErrNotConfirmed = ProjectorError("Irreversible command not confirmed")
+
+// ResetLampHours zeroes the lamp hour counter, as after fitting a new lamp.
+// The old count cannot be recovered, so nothing is sent unless force is
+// true.
+func (p *Projector) ResetLampHours(force bool) error {
+	return p.ResetLampHoursContext(context.Background(), force)
+}
+
+func (p *Projector) ResetLampHoursContext(ctx context.Context, force bool) error {
+	return p.resetLampHoursContext(ctx, force)
+}
+
+// ResetFilterHours zeroes the filter hour counter once the dust filter has
+// been cleaned or replaced. As with ResetLampHours, nothing is sent unless
+// force is true.
+func (p *Projector) ResetFilterHours(force bool) error {
+	return p.ResetFilterHoursContext(context.Background(), force)
+}
+
+func (p *Projector) ResetFilterHoursContext(ctx context.Context, force bool) error {
+	return p.resetFilterHoursContext(ctx, force)
+}
@@ -0,0 +1,32 @@
+package projector
+
+// Middleware observes a frame on its way to or from the wire: raw is the
+// encoded frame and packet its decoded form. It runs synchronously, so it
+// may also delay traffic. Returning an error aborts the exchange with that
+// error.
+type Middleware func(raw []byte, packet *Packet) error
+
+// OnSend registers a middleware run, in registration order, before each
+// packet is written.
+func (p *Conn) OnSend(mw Middleware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onSend = append(p.onSend, mw)
+}
+
+// OnReceive registers a middleware run, in registration order, on each
+// frame read that passed its checksum.
+func (p *Conn) OnReceive(mw Middleware) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.onReceive = append(p.onReceive, mw)
+}
+
+func runMiddleware(mws []Middleware, raw []byte, packet *Packet) error {
+	for _, mw := range mws {
+		if err := mw(raw, packet); err != nil {
+			return err
+		}
+	}
+	return nil
+}
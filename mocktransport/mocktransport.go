@@ -0,0 +1,214 @@
+// Package mocktransport provides an in-memory projector.Transport for
+// testing code against the projector package without hardware.
+//
+//	mock := mocktransport.New()
+//	mock.Reply(mocktransport.Response(0x01))
+//	p := projector.New(mock)
+//	on, err := p.PowerState()
+package mocktransport
+
+import (
+	"sync"
+
+	projector "github.com/echo1001/go-viewsonic"
+)
+
+// Transport records everything written to it and answers each Write with
+// the next queued reply. Reads with nothing pending return (0, nil) like a
+// serial port hitting its read timeout. It is safe for concurrent use.
+type Transport struct {
+	mu       sync.Mutex
+	replies  [][]byte
+	readable []byte
+	written  [][]byte
+	closed   bool
+
+	readErrs  []error
+	writeErrs []error
+	flushErrs []error
+
+	// Responder, if set, is consulted for writes that have no queued reply.
+	Responder func(written []byte) []byte
+}
+
+func New() *Transport {
+	return &Transport{}
+}
+
+// Ack builds the acknowledgement a projector sends for a write command.
+func Ack() projector.Packet {
+	return projector.Packet{Command: projector.COMMAND_ACK, Data: []byte{}}
+}
+
+// Response builds a read response carrying value after the two leading
+// status bytes, as the projector does.
+func Response(value ...byte) projector.Packet {
+	return projector.Packet{Command: projector.COMMAND_RESPONSE, Data: append([]byte{0x00, 0x00}, value...)}
+}
+
+// Exception builds an exception packet with the given payload.
+func Exception(data ...byte) projector.Packet {
+	return projector.Packet{Command: projector.COMMAND_EXCEPTION, Data: data}
+}
+
+// Reply queues packets to be returned, one per subsequent Write.
+func (t *Transport) Reply(packets ...projector.Packet) {
+	for _, packet := range packets {
+		t.ReplyRaw(packet.Build())
+	}
+}
+
+// ReplyRaw queues raw bytes to be returned after the next Write, e.g. a
+// corrupted or truncated frame.
+func (t *Transport) ReplyRaw(b []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.replies = append(t.replies, append([]byte{}, b...))
+}
+
+// Inject makes bytes readable immediately, without waiting for a Write, to
+// simulate unsolicited frames or line noise.
+func (t *Transport) Inject(b []byte) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.readable = append(t.readable, b...)
+}
+
+// FailRead makes the next Read return err. Calls queue up in order.
+func (t *Transport) FailRead(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.readErrs = append(t.readErrs, err)
+}
+
+// FailWrite makes the next Write return err without consuming a reply.
+func (t *Transport) FailWrite(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.writeErrs = append(t.writeErrs, err)
+}
+
+// FailFlush makes the next Flush return err.
+func (t *Transport) FailFlush(err error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.flushErrs = append(t.flushErrs, err)
+}
+
+// Written returns a copy of every Write, in order.
+func (t *Transport) Written() [][]byte {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var written [][]byte
+	for _, b := range t.written {
+		written = append(written, append([]byte{}, b...))
+	}
+	return written
+}
+
+// Packets decodes the recorded writes. Writes too short to be a frame are
+// skipped.
+func (t *Transport) Packets() []projector.Packet {
+	var packets []projector.Packet
+	for _, b := range t.Written() {
+		if len(b) < 6 {
+			continue
+		}
+		packets = append(packets, projector.Packet{Command: projector.CommandType(b[0]), ID: b[2], Data: b[5 : len(b)-1]})
+	}
+	return packets
+}
+
+// Pending reports how many queued replies have not been consumed yet.
+func (t *Transport) Pending() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.replies)
+}
+
+// Buffered reports how many bytes are readable now, including injected
+// ones.
+func (t *Transport) Buffered() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return len(t.readable)
+}
+
+// Closed reports whether Close has been called.
+func (t *Transport) Closed() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.closed
+}
+
+// Reset discards queued replies, readable bytes, recorded writes and
+// injected errors.
+func (t *Transport) Reset() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.replies = nil
+	t.readable = nil
+	t.written = nil
+	t.readErrs = nil
+	t.writeErrs = nil
+	t.flushErrs = nil
+	t.closed = false
+}
+
+func (t *Transport) Read(b []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.readErrs) > 0 {
+		err := t.readErrs[0]
+		t.readErrs = t.readErrs[1:]
+		return 0, err
+	}
+	if t.closed {
+		return 0, projector.ProjectorError("mocktransport: closed")
+	}
+	n := copy(b, t.readable)
+	t.readable = t.readable[n:]
+	return n, nil
+}
+
+func (t *Transport) Write(b []byte) (int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.writeErrs) > 0 {
+		err := t.writeErrs[0]
+		t.writeErrs = t.writeErrs[1:]
+		return 0, err
+	}
+	if t.closed {
+		return 0, projector.ProjectorError("mocktransport: closed")
+	}
+	t.written = append(t.written, append([]byte{}, b...))
+	if len(t.replies) > 0 {
+		t.readable = append(t.readable, t.replies[0]...)
+		t.replies = t.replies[1:]
+	} else if t.Responder != nil {
+		t.readable = append(t.readable, t.Responder(b)...)
+	}
+	return len(b), nil
+}
+
+func (t *Transport) Flush() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if len(t.flushErrs) > 0 {
+		err := t.flushErrs[0]
+		t.flushErrs = t.flushErrs[1:]
+		return err
+	}
+	t.readable = nil
+	return nil
+}
+
+func (t *Transport) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.closed = true
+	return nil
+}
+
+var _ projector.Transport = (*Transport)(nil)
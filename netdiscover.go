@@ -0,0 +1,144 @@
+package projector
+
+import (
+	"context"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/echo1001/go-viewsonic/pjlink"
+)
+
+// DefaultControlPort is the LAN port ViewSonic projectors expose the RS-232
+// command protocol on.
+const DefaultControlPort = 4661
+
+const defaultDiscoveryTimeout = time.Second * 3
+
+type NetworkProjector struct {
+	IP    net.IP
+	MAC   string
+	Model string
+	Name  string
+}
+
+// Dial opens the projector with the TCP transport on its control port,
+// selecting a ModelProfile from Model when one matches.
+func (n NetworkProjector) Dial(opts ...Option) (*Projector, error) {
+	if m := ModelFor(n.Model); m != nil {
+		opts = append([]Option{WithModel(m)}, opts...)
+	}
+	return DialTCP(net.JoinHostPort(n.IP.String(), strconv.Itoa(DefaultControlPort)), opts...)
+}
+
+// DiscoverNetwork broadcasts a PJLink class 2 search (SRCH) on every IPv4
+// interface and collects the projectors that answer until ctx is done, or for
+// three seconds if ctx has no deadline. Model and name are filled in from
+// PJLink where the projector does not require a password. Replies arrive on
+// UDP port 4352, so it fails if that port cannot be bound, e.g. because
+// another PJLink tool on the host holds it.
+func DiscoverNetwork(ctx context.Context) ([]NetworkProjector, error) {
+	if _, ok := ctx.Deadline(); !ok {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, defaultDiscoveryTimeout)
+		defer cancel()
+	}
+
+	// Projectors answer on the PJLink port, so no other port would hear
+	// them.
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{Port: pjlink.DefaultPort})
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+
+	search := []byte("%2SRCH\r")
+	sent := false
+	for _, addr := range broadcastAddrs() {
+		if _, err := conn.WriteToUDP(search, &net.UDPAddr{IP: addr, Port: pjlink.DefaultPort}); err == nil {
+			sent = true
+		}
+	}
+	if !sent {
+		return nil, ProjectorError("Discovery broadcast failed")
+	}
+
+	deadline, _ := ctx.Deadline()
+	conn.SetReadDeadline(deadline)
+	go func() {
+		<-ctx.Done()
+		conn.SetReadDeadline(time.Now())
+	}()
+
+	seen := map[string]bool{}
+	var found []NetworkProjector
+	buffer := make([]byte, 512)
+	for {
+		n, from, err := conn.ReadFromUDP(buffer)
+		if err != nil {
+			break
+		}
+		reply := strings.TrimSpace(string(buffer[:n]))
+		if !strings.HasPrefix(reply, "%2ACKN=") {
+			continue
+		}
+		if seen[from.IP.String()] {
+			continue
+		}
+		seen[from.IP.String()] = true
+		found = append(found, NetworkProjector{IP: from.IP, MAC: strings.TrimPrefix(reply, "%2ACKN=")})
+	}
+
+	var wg sync.WaitGroup
+	for i := range found {
+		wg.Add(1)
+		go func(n *NetworkProjector) {
+			defer wg.Done()
+			client, err := pjlink.DialTimeout(n.IP.String(), "", time.Second*2)
+			if err != nil {
+				return
+			}
+			defer client.Close()
+			n.Model, _ = client.ProductName()
+			n.Name, _ = client.Name()
+		}(&found[i])
+	}
+	wg.Wait()
+	return found, nil
+}
+
+func broadcastAddrs() []net.IP {
+	addrs := []net.IP{net.IPv4bcast}
+	ifaces, err := net.Interfaces()
+	if err != nil {
+		return addrs
+	}
+	for _, iface := range ifaces {
+		if iface.Flags&net.FlagUp == 0 || iface.Flags&net.FlagBroadcast == 0 {
+			continue
+		}
+		ifaceAddrs, err := iface.Addrs()
+		if err != nil {
+			continue
+		}
+		for _, addr := range ifaceAddrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok || ipNet.IP.To4() == nil {
+				continue
+			}
+			ip := ipNet.IP.To4()
+			mask := ipNet.Mask
+			if len(mask) == net.IPv6len {
+				mask = mask[12:]
+			}
+			broadcast := make(net.IP, net.IPv4len)
+			for i := range ip {
+				broadcast[i] = ip[i] | ^mask[i]
+			}
+			addrs = append(addrs, broadcast)
+		}
+	}
+	return addrs
+}
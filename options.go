@@ -0,0 +1,133 @@
+package projector
+
+import "time"
+
+type Parity byte
+
+const PARITY_NONE Parity = 'N'
+const PARITY_ODD Parity = 'O'
+const PARITY_EVEN Parity = 'E'
+const PARITY_MARK Parity = 'M'
+const PARITY_SPACE Parity = 'S'
+
+type StopBits byte
+
+const STOP_BITS_1 StopBits = 1
+const STOP_BITS_1_5 StopBits = 15
+const STOP_BITS_2 StopBits = 2
+
+// SerialConfig holds the line settings. Zero fields fall back to
+// DefaultSerialConfig.
+type SerialConfig struct {
+	Baud        int
+	DataBits    byte
+	Parity      Parity
+	StopBits    StopBits
+	ReadTimeout time.Duration
+}
+
+// DefaultSerialConfig is 115200/8N1, which current ViewSonic models use.
+var DefaultSerialConfig = SerialConfig{
+	Baud:        115200,
+	DataBits:    8,
+	Parity:      PARITY_NONE,
+	StopBits:    STOP_BITS_1,
+	ReadTimeout: time.Millisecond * 100,
+}
+
+func (c SerialConfig) withDefaults() SerialConfig {
+	if c.Baud == 0 {
+		c.Baud = DefaultSerialConfig.Baud
+	}
+	if c.DataBits == 0 {
+		c.DataBits = DefaultSerialConfig.DataBits
+	}
+	if c.Parity == 0 {
+		c.Parity = DefaultSerialConfig.Parity
+	}
+	if c.StopBits == 0 {
+		c.StopBits = DefaultSerialConfig.StopBits
+	}
+	if c.ReadTimeout == 0 {
+		c.ReadTimeout = DefaultSerialConfig.ReadTimeout
+	}
+	return c
+}
+
+// Option adjusts how a Projector opens and talks to its port. Options passed
+// to Open or a Dial function stay in effect for later reconnects.
+type Option func(*Projector)
+
+// WithBaud sets the baud rate; older units ship at 9600 or 19200.
+func WithBaud(baud int) Option {
+	return func(p *Projector) {
+		p.config.Baud = baud
+	}
+}
+
+func WithDataBits(bits byte) Option {
+	return func(p *Projector) {
+		p.config.DataBits = bits
+	}
+}
+
+func WithParity(parity Parity) Option {
+	return func(p *Projector) {
+		p.config.Parity = parity
+	}
+}
+
+func WithStopBits(stopBits StopBits) Option {
+	return func(p *Projector) {
+		p.config.StopBits = stopBits
+	}
+}
+
+// WithReadTimeout sets how long a single read waits for data.
+func WithReadTimeout(timeout time.Duration) Option {
+	return func(p *Projector) {
+		p.config.ReadTimeout = timeout
+	}
+}
+
+// WithSerialConfig replaces all line settings at once.
+func WithSerialConfig(config SerialConfig) Option {
+	return func(p *Projector) {
+		p.config = config
+	}
+}
+
+// WithSerialBackend selects the library used to open local serial devices.
+// The default is TarmBackend.
+func WithSerialBackend(backend SerialBackend) Option {
+	return func(p *Projector) {
+		p.backend = backend
+	}
+}
+
+// SerialConfig returns the line settings in effect, defaults included.
+func (p *Conn) SerialConfig() SerialConfig {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.config.withDefaults()
+}
+
+// WithProtocolVariant selects the frame layout; Probe detects it.
+func WithProtocolVariant(v ProtocolVariant) Option {
+	return func(p *Projector) {
+		p.variant = v
+	}
+}
+
+// ProtocolVariant returns the frame layout in use.
+func (p *Conn) ProtocolVariant() ProtocolVariant {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.variant
+}
+
+func (p *Projector) apply(opts []Option) {
+	for _, opt := range opts {
+		opt(p)
+	}
+}
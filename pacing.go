@@ -0,0 +1,69 @@
+package projector
+
+import (
+	"context"
+	"time"
+)
+
+// Pacing slows the client down for firmware that chokes on back-to-back
+// traffic. The zero value applies no pacing.
+type Pacing struct {
+	// CommandGap is the minimum time from the end of one exchange to the
+	// start of the next.
+	CommandGap time.Duration
+	// PowerDelay holds off further commands after a power on/off while the
+	// projector's controller is busy with the transition.
+	PowerDelay time.Duration
+	// ByteGap, if set, writes frames one byte at a time with this delay
+	// between bytes.
+	ByteGap time.Duration
+}
+
+func WithPacing(pacing Pacing) Option {
+	return func(p *Projector) {
+		p.pacing = pacing
+	}
+}
+
+// waitPacing sleeps until the next exchange is allowed. Callers hold p.mu.
+func (p *Conn) waitPacing(ctx context.Context) error {
+	until := p.lastExchange.Add(p.pacing.CommandGap)
+	if p.holdUntil.After(until) {
+		until = p.holdUntil
+	}
+	return sleepContext(ctx, time.Until(until))
+}
+
+// paced records the end of an exchange of packet. Callers hold p.mu.
+func (p *Conn) paced(ctx context.Context, packet Packet) {
+	p.lastExchange = time.Now()
+	if p.pacing.PowerDelay > 0 && isPowerWrite(ctx, packet) {
+		p.holdUntil = p.lastExchange.Add(p.pacing.PowerDelay)
+	}
+}
+
+func isPowerWrite(ctx context.Context, packet Packet) bool {
+	c, ok := specFor(ctx, packet)
+	return ok && c.Power && packet.Command == COMMAND_WRITE
+}
+
+func (p *Conn) writeRaw(ctx context.Context, raw []byte) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if p.pacing.ByteGap <= 0 {
+		_, err := p.Port.Write(raw)
+		return err
+	}
+	for i := range raw {
+		if i > 0 {
+			if err := sleepContext(ctx, p.pacing.ByteGap); err != nil {
+				return err
+			}
+		}
+		if _, err := p.Port.Write(raw[i : i+1]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,289 @@
+package projector
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+)
+
+type CommandType byte
+
+const COMMAND_EXCEPTION CommandType = 0
+const COMMAND_ACK CommandType = 3
+const COMMAND_RESPONSE CommandType = 5
+const COMMAND_WRITE CommandType = 6
+const COMMAND_READ CommandType = 7
+
+func (c CommandType) String() string {
+	switch c {
+	case COMMAND_EXCEPTION:
+		return "exception"
+	case COMMAND_ACK:
+		return "ack"
+	case COMMAND_RESPONSE:
+		return "response"
+	case COMMAND_WRITE:
+		return "write"
+	case COMMAND_READ:
+		return "read"
+	}
+	return fmt.Sprintf("command 0x%02x", byte(c))
+}
+
+// expectedReply returns the packet type the projector answers command with:
+// writes are acknowledged, reads get a response.
+func expectedReply(command CommandType) (CommandType, bool) {
+	switch command {
+	case COMMAND_WRITE:
+		return COMMAND_ACK, true
+	case COMMAND_READ:
+		return COMMAND_RESPONSE, true
+	}
+	return 0, false
+}
+
+// ProtocolError is returned when the reply is of the wrong type for the
+// request, e.g. a data response to a write.
+type ProtocolError struct {
+	Expected CommandType
+	Packet   *Packet
+}
+
+func (e *ProtocolError) Error() string {
+	return fmt.Sprintf("Protocol error: expected %s, got %s", e.Expected, e.Packet.Command)
+}
+
+// ProtocolVariant selects the frame layout.
+type ProtocolVariant byte
+
+// PROTOCOL_STANDARD frames are cmd 14 id lenLo lenHi data checksum.
+const PROTOCOL_STANDARD ProtocolVariant = 0
+
+// PROTOCOL_LEGACY frames, from older firmware, have a single length byte
+// and no projector ID: cmd 14 len data checksum. The checksum is computed
+// the same way.
+const PROTOCOL_LEGACY ProtocolVariant = 1
+
+func (v ProtocolVariant) String() string {
+	if v == PROTOCOL_LEGACY {
+		return "legacy"
+	}
+	return "standard"
+}
+
+func (v ProtocolVariant) headerLen() int {
+	if v == PROTOCOL_LEGACY {
+		return 3
+	}
+	return 5
+}
+
+// build frames p in the variant's layout with the given checksum.
+func (v ProtocolVariant) build(p Packet, sum Checksum) []byte {
+	var frame []byte
+	if v != PROTOCOL_LEGACY {
+		frame = append([]byte{byte(p.Command), 0x14, p.ID}, p.DataLength()...)
+	} else {
+		p.ID = 0
+		frame = []byte{byte(p.Command), 0x14, byte(len(p.Data))}
+	}
+	frame = append(frame, p.Data...)
+	return append(frame, sum.of(&p))
+}
+
+type Packet struct {
+	Command CommandType
+	// ID addresses one projector on a daisy-chained bus. It travels in the
+	// header byte after 0x14; 0 is accepted by every unit.
+	ID   byte
+	Data []byte
+}
+
+func (p *Packet) DataLength() []byte {
+	var lenBytes = []byte{0, 0}
+	var numBytes = len(p.Data)
+	lenBytes[0] = byte((numBytes & 0x00FF))
+	lenBytes[1] = byte((numBytes & 0xFF00) >> 8)
+
+	return lenBytes
+}
+
+func (p *Packet) Checksum() byte {
+	var sum byte = 0
+	var lenBytes = p.DataLength()
+	sum += p.ID
+	sum += lenBytes[0] + lenBytes[1]
+	for _, b := range p.Data {
+		sum += b
+	}
+	return sum + 0x14
+}
+
+func (p *Packet) Build() []byte {
+	var bytes []byte = []byte{byte(p.Command)}
+
+	bytes = append(bytes, []byte{0x14, p.ID}...)
+	bytes = append(bytes, p.DataLength()...)
+	bytes = append(bytes, p.Data...)
+	bytes = append(bytes, p.Checksum())
+
+	return bytes
+}
+
+// MaxPayloadSize is the largest data length accepted when encoding or
+// parsing a frame. Real frames carry a few bytes of data, so anything
+// bigger is a corrupt length field.
+const MaxPayloadSize = 0x40
+
+// PayloadSizeError is returned for a frame whose data length exceeds
+// MaxPayloadSize.
+type PayloadSizeError struct {
+	Length int
+}
+
+func (e *PayloadSizeError) Error() string {
+	return fmt.Sprintf("Payload of %d bytes exceeds limit of %d", e.Length, MaxPayloadSize)
+}
+
+// Validate checks that the packet can be framed.
+func (p *Packet) Validate() error {
+	if len(p.Data) > MaxPayloadSize {
+		return &PayloadSizeError{Length: len(p.Data)}
+	}
+	return nil
+}
+
+// Encode writes the framed packet to w.
+func (p *Packet) Encode(w io.Writer) error {
+	if err := p.Validate(); err != nil {
+		return err
+	}
+	_, err := w.Write(p.Build())
+	return err
+}
+
+// ParsePacket reads one frame from r and verifies its checksum. Junk before
+// the frame is skipped up to MaxResyncSkip bytes, counting headers whose
+// length exceeds MaxPayloadSize as junk. A read that returns no
+// data, as a serial port does on timeout, ends the frame: it yields io.EOF
+// if nothing had been read yet and ErrTruncatedResponse if the frame was cut
+// short.
+func ParsePacket(r io.Reader) (*Packet, error) {
+	packet, _, err := parseFrame(r, time.Time{}, PROTOCOL_STANDARD, nil)
+	return packet, err
+}
+
+// MaxResyncSkip is how many bytes of line noise ParsePacket discards while
+// looking for a frame header before it gives up.
+const MaxResyncSkip = 256
+
+// MaxFrameBytes is the most parsing one frame will consume: the noise
+// skipped while resyncing plus the largest valid frame. The length field is
+// checked before anything is allocated for the data.
+const MaxFrameBytes = MaxResyncSkip + 5 + MaxPayloadSize + 1
+
+// DecodeFrame parses one frame from the start of b in the given layout and
+// reports how many bytes it consumed, including noise skipped ahead of it.
+// It never reads beyond b and consumes at most MaxFrameBytes, so any input
+// yields a packet or an error; it is the entry point for fuzzing the
+// parser. Empty b gives io.EOF; b ending before a frame is complete, noise
+// included, gives ErrTruncatedResponse.
+func DecodeFrame(b []byte, variant ProtocolVariant) (*Packet, int, error) {
+	r := bytes.NewReader(b)
+	packet, _, err := parseFrame(r, time.Time{}, variant, nil)
+	return packet, len(b) - r.Len(), err
+}
+
+// idlePoll is the pause after a read that returned nothing, so a transport
+// that does not block cannot spin while waiting for a reply.
+const idlePoll = 10 * time.Millisecond
+
+// parseFrame is ParsePacket that also returns the raw frame bytes. With a
+// non-zero deadline, reads that return nothing are retried until it passes
+// instead of ending the frame.
+func parseFrame(r io.Reader, deadline time.Time, variant ProtocolVariant, sum Checksum) (*Packet, []byte, error) {
+	preamble := make([]byte, variant.headerLen())
+	last := len(preamble) - 1
+	n, err := readFrameBytes(r, preamble, deadline)
+	for skipped := 0; err == nil && !frameStart(preamble, variant); skipped++ {
+		if skipped == MaxResyncSkip {
+			return nil, nil, ProjectorError("Lost frame sync")
+		}
+		copy(preamble, preamble[1:])
+		_, err = readFrameBytes(r, preamble[last:], deadline)
+	}
+	if err != nil {
+		if err == io.EOF && n > 0 {
+			err = ErrTruncatedResponse
+		}
+		return nil, nil, err
+	}
+
+	var packet = Packet{}
+	packet.Command = CommandType(preamble[0])
+	if variant != PROTOCOL_LEGACY {
+		packet.ID = preamble[2]
+	}
+	dataLength := frameLength(preamble, variant)
+	rest := make([]byte, dataLength+1)
+	if _, err = readFrameBytes(r, rest, deadline); err != nil {
+		if err == io.EOF {
+			err = ErrTruncatedResponse
+		}
+		return nil, nil, err
+	}
+	packet.Data = rest[:dataLength]
+
+	if want := sum.of(&packet); want != rest[dataLength] {
+		return nil, nil, &ChecksumError{Packet: &packet, Got: rest[dataLength], Want: want}
+	}
+	return &packet, append(preamble, rest...), nil
+}
+
+// frameStart reports whether h looks like the start of a frame: a known
+// packet type followed by the 0x14 marker and a plausible data length.
+func frameStart(h []byte, variant ProtocolVariant) bool {
+	switch CommandType(h[0]) {
+	case COMMAND_EXCEPTION, COMMAND_ACK, COMMAND_RESPONSE, COMMAND_WRITE, COMMAND_READ:
+	default:
+		return false
+	}
+	return h[1] == 0x14 && frameLength(h, variant) <= MaxPayloadSize
+}
+
+// frameLength returns the data length from header h.
+func frameLength(h []byte, variant ProtocolVariant) int {
+	if variant == PROTOCOL_LEGACY {
+		return int(h[2])
+	}
+	return int(h[3]) + (int(h[4]) << 8)
+}
+
+// readFrameBytes fills b. A read that returns no data, or io.EOF as tarm
+// does on a serial read timeout, stops it early with io.EOF, or once
+// deadline has passed if one is set.
+func readFrameBytes(r io.Reader, b []byte, deadline time.Time) (int, error) {
+	count := 0
+	for count < len(b) {
+		n, err := r.Read(b[count:])
+		if n < 0 || n > len(b)-count {
+			return count, ProjectorError("Transport returned invalid read count")
+		}
+		count += n
+		if count == len(b) {
+			break
+		}
+		if err != nil && err != io.EOF {
+			return count, err
+		}
+		if n > 0 {
+			continue
+		}
+		if deadline.IsZero() || time.Now().After(deadline) {
+			return count, io.EOF
+		}
+		time.Sleep(idlePoll)
+	}
+	return count, nil
+}
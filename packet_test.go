@@ -0,0 +1,102 @@
+package projector_test
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+
+	projector "github.com/echo1001/go-viewsonic"
+	"github.com/echo1001/go-viewsonic/mocktransport"
+)
+
+func frame(p projector.Packet) []byte {
+	return p.Build()
+}
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+var (
+	ack      = frame(mocktransport.Ack())
+	response = frame(mocktransport.Response(0x01))
+	noise    = []byte{0xff, 0x00, 0x14, 0x7e}
+	// badLength has a plausible type and marker but a length far beyond
+	// MaxPayloadSize, so it must be skipped as noise.
+	badLength = []byte{0x05, 0x14, 0x00, 0xff, 0xff}
+)
+
+func TestDecodeFrame(t *testing.T) {
+	corrupt := append([]byte(nil), response...)
+	corrupt[len(corrupt)-1] ^= 0xff
+	tests := []struct {
+		name     string
+		in       []byte
+		variant  projector.ProtocolVariant
+		want     projector.CommandType
+		consumed int
+		err      error
+	}{
+		{"ack", ack, projector.PROTOCOL_STANDARD, projector.COMMAND_ACK, len(ack), nil},
+		{"response", response, projector.PROTOCOL_STANDARD, projector.COMMAND_RESPONSE, len(response), nil},
+		{"trailing bytes", concat(ack, response), projector.PROTOCOL_STANDARD, projector.COMMAND_ACK, len(ack), nil},
+		{"noise", concat(noise, ack), projector.PROTOCOL_STANDARD, projector.COMMAND_ACK, len(noise) + len(ack), nil},
+		{"bad length", concat(badLength, ack), projector.PROTOCOL_STANDARD, projector.COMMAND_ACK, len(badLength) + len(ack), nil},
+		{"legacy", []byte{0x05, 0x14, 0x03, 0x00, 0x00, 0x01, 0x18}, projector.PROTOCOL_LEGACY, projector.COMMAND_RESPONSE, 7, nil},
+		{"empty", nil, projector.PROTOCOL_STANDARD, 0, 0, io.EOF},
+		{"truncated header", ack[:4], projector.PROTOCOL_STANDARD, 0, 4, projector.ErrTruncatedResponse},
+		{"truncated data", response[:len(response)-1], projector.PROTOCOL_STANDARD, 0, len(response) - 1, projector.ErrTruncatedResponse},
+		{"checksum", corrupt, projector.PROTOCOL_STANDARD, 0, len(corrupt), projector.ErrChecksum},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			packet, consumed, err := projector.DecodeFrame(tt.in, tt.variant)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("err = %v, want %v", err, tt.err)
+			}
+			if consumed != tt.consumed {
+				t.Errorf("consumed %d, want %d", consumed, tt.consumed)
+			}
+			if err == nil && packet.Command != tt.want {
+				t.Errorf("decoded %s, want %s", packet.Command, tt.want)
+			}
+		})
+	}
+}
+
+func TestDecodeFrameResyncBudget(t *testing.T) {
+	within := concat(bytes.Repeat([]byte{0xff}, projector.MaxResyncSkip), ack)
+	if packet, _, err := projector.DecodeFrame(within, projector.PROTOCOL_STANDARD); err != nil || packet.Command != projector.COMMAND_ACK {
+		t.Fatalf("within budget: %v, %v", packet, err)
+	}
+	beyond := concat(bytes.Repeat([]byte{0xff}, projector.MaxResyncSkip+1), ack)
+	_, consumed, err := projector.DecodeFrame(beyond, projector.PROTOCOL_STANDARD)
+	if err == nil || errors.Is(err, projector.ErrTruncatedResponse) {
+		t.Fatalf("beyond budget: err = %v", err)
+	}
+	if consumed > projector.MaxFrameBytes {
+		t.Errorf("consumed %d, more than MaxFrameBytes", consumed)
+	}
+}
+
+func FuzzDecodeFrame(f *testing.F) {
+	f.Add(ack, byte(projector.PROTOCOL_STANDARD))
+	f.Add(response, byte(projector.PROTOCOL_STANDARD))
+	f.Add(concat(noise, ack), byte(projector.PROTOCOL_STANDARD))
+	f.Add(concat(badLength, response), byte(projector.PROTOCOL_STANDARD))
+	f.Add(response[:3], byte(projector.PROTOCOL_STANDARD))
+	f.Add([]byte{0x05, 0x14, 0x03, 0x00, 0x00, 0x01, 0x18}, byte(projector.PROTOCOL_LEGACY))
+	f.Fuzz(func(t *testing.T, b []byte, variant byte) {
+		packet, consumed, err := projector.DecodeFrame(b, projector.ProtocolVariant(variant%2))
+		if consumed < 0 || consumed > len(b) {
+			t.Fatalf("consumed %d of %d bytes", consumed, len(b))
+		}
+		if consumed > projector.MaxFrameBytes {
+			t.Fatalf("consumed %d, more than MaxFrameBytes", consumed)
+		}
+		if err == nil && (packet == nil || len(packet.Data) > projector.MaxPayloadSize) {
+			t.Fatalf("decoded %v without error", packet)
+		}
+	})
+}
@@ -0,0 +1,130 @@
+package projector
+
+import (
+	"context"
+	"errors"
+)
+
+// Picture is a set of picture adjustments, read and applied together so a
+// known-good calibration can be saved and restored.
+type Picture struct {
+	Brightness uint8
+	Contrast   uint8
+	Sharpness  uint8
+	Hue        int8
+	Saturation uint8
+}
+
+// WhiteBalance is the per-channel gain and offset calibration.
+type WhiteBalance struct {
+	RedGain, GreenGain, BlueGain       uint8
+	RedOffset, GreenOffset, BlueOffset int8
+}
+
+type settingField struct {
+	name string
+	get  func() int
+	set  func(v int)
+}
+
+func uint8Field(name string, f *uint8) settingField {
+	return settingField{name, func() int { return int(*f) }, func(v int) { *f = uint8(v) }}
+}
+
+func int8Field(name string, f *int8) settingField {
+	return settingField{name, func() int { return int(*f) }, func(v int) { *f = int8(v) }}
+}
+
+func (pic *Picture) fields() []settingField {
+	return []settingField{
+		uint8Field("brightness", &pic.Brightness),
+		uint8Field("contrast", &pic.Contrast),
+		uint8Field("sharpness", &pic.Sharpness),
+		int8Field("hue", &pic.Hue),
+		uint8Field("saturation", &pic.Saturation),
+	}
+}
+
+func (wb *WhiteBalance) fields() []settingField {
+	return []settingField{
+		uint8Field("red_gain", &wb.RedGain),
+		uint8Field("green_gain", &wb.GreenGain),
+		uint8Field("blue_gain", &wb.BlueGain),
+		int8Field("red_offset", &wb.RedOffset),
+		int8Field("green_offset", &wb.GreenOffset),
+		int8Field("blue_offset", &wb.BlueOffset),
+	}
+}
+
+// Picture reads the current picture adjustments.
+func (p *Projector) Picture() (*Picture, error) {
+	return p.PictureContext(context.Background())
+}
+
+func (p *Projector) PictureContext(ctx context.Context) (*Picture, error) {
+	pic := &Picture{}
+	if err := p.readFields(ctx, pic.fields()); err != nil {
+		return nil, err
+	}
+	return pic, nil
+}
+
+// SetPicture applies every adjustment in pic as one Batch, so no other
+// command runs in between, and returns the first failure.
+func (p *Projector) SetPicture(pic Picture) error {
+	return p.SetPictureContext(context.Background(), pic)
+}
+
+func (p *Projector) SetPictureContext(ctx context.Context, pic Picture) error {
+	return p.applyFields(ctx, pic.fields())
+}
+
+// WhiteBalance reads the current white balance calibration.
+func (p *Projector) WhiteBalance() (*WhiteBalance, error) {
+	return p.WhiteBalanceContext(context.Background())
+}
+
+func (p *Projector) WhiteBalanceContext(ctx context.Context) (*WhiteBalance, error) {
+	wb := &WhiteBalance{}
+	if err := p.readFields(ctx, wb.fields()); err != nil {
+		return nil, err
+	}
+	return wb, nil
+}
+
+// SetWhiteBalance applies wb as one Batch, like SetPicture.
+func (p *Projector) SetWhiteBalance(wb WhiteBalance) error {
+	return p.SetWhiteBalanceContext(context.Background(), wb)
+}
+
+func (p *Projector) SetWhiteBalanceContext(ctx context.Context, wb WhiteBalance) error {
+	return p.applyFields(ctx, wb.fields())
+}
+
+func (p *Projector) readFields(ctx context.Context, fields []settingField) error {
+	for _, f := range fields {
+		v, err := p.get(ctx, f.name)
+		if err != nil {
+			return err
+		}
+		f.set(v)
+	}
+	return nil
+}
+
+func (p *Projector) applyFields(ctx context.Context, fields []settingField) error {
+	var ops []BatchOp
+	for _, f := range fields {
+		ops = append(ops, BatchOp{Command: f.name, Value: f.get()})
+	}
+	results, err := p.Batch(ctx, ops...)
+	if err != nil {
+		return err
+	}
+	for _, err := range results {
+		if err != nil && !errors.Is(err, ErrBatchAborted) {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,378 @@
+// Package pjlink implements a PJLink class 1 client for network projectors.
+//
+// Protocol Ref: https://pjlink.jbmia.or.jp/english/data_cl2/PJLink_5-1.pdf
+package pjlink
+
+import (
+	"bufio"
+	"crypto/md5"
+	"encoding/hex"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const DefaultPort = 4352
+const DefaultTimeout = time.Second * 5
+
+type Error string
+
+func (e Error) Error() string {
+	return string(e)
+}
+
+const ErrUndefinedCommand = Error("PJLink: undefined command")
+const ErrOutOfParameter = Error("PJLink: out of parameter")
+const ErrUnavailableTime = Error("PJLink: unavailable time")
+const ErrProjectorFailure = Error("PJLink: projector failure")
+const ErrAuth = Error("PJLink: authentication failed")
+const ErrBadResponse = Error("PJLink: malformed response")
+
+type PowerStatus byte
+
+const POWER_OFF PowerStatus = 0
+const POWER_ON PowerStatus = 1
+const POWER_COOLING PowerStatus = 2
+const POWER_WARMING PowerStatus = 3
+
+// Input is a PJLink input: a type digit (1 RGB, 2 Video, 3 Digital,
+// 4 Storage, 5 Network) followed by an input number digit, e.g. "31".
+type Input string
+
+const INPUT_RGB1 Input = "11"
+const INPUT_RGB2 Input = "12"
+const INPUT_VIDEO1 Input = "21"
+const INPUT_VIDEO2 Input = "22"
+const INPUT_DIGITAL1 Input = "31"
+const INPUT_DIGITAL2 Input = "32"
+const INPUT_STORAGE1 Input = "41"
+const INPUT_NETWORK1 Input = "51"
+
+type AVMute struct {
+	Video bool
+	Audio bool
+}
+
+type Lamp struct {
+	Hours uint32
+	On    bool
+}
+
+type ErrorLevel byte
+
+const ERROR_OK ErrorLevel = 0
+const ERROR_WARNING ErrorLevel = 1
+const ERROR_ERROR ErrorLevel = 2
+
+type ErrorStatus struct {
+	Fan         ErrorLevel
+	Lamp        ErrorLevel
+	Temperature ErrorLevel
+	Cover       ErrorLevel
+	Filter      ErrorLevel
+	Other       ErrorLevel
+}
+
+// Client talks to one projector. Projectors close idle PJLink connections
+// after ~30s, so the client reconnects (and re-authenticates) on demand.
+type Client struct {
+	addr     string
+	password string
+	timeout  time.Duration
+	conn     net.Conn
+	reader   *bufio.Reader
+	digest   string
+}
+
+// Dial connects to addr ("host" or "host:port") using password for
+// authentication when the projector requires it.
+func Dial(addr, password string) (*Client, error) {
+	return DialTimeout(addr, password, DefaultTimeout)
+}
+
+func DialTimeout(addr, password string, timeout time.Duration) (*Client, error) {
+	if _, _, err := net.SplitHostPort(addr); err != nil {
+		addr = net.JoinHostPort(addr, strconv.Itoa(DefaultPort))
+	}
+	c := &Client{addr: addr, password: password, timeout: timeout}
+	if err := c.connect(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *Client) connect() error {
+	c.Close()
+	conn, err := net.DialTimeout("tcp", c.addr, c.timeout)
+	if err != nil {
+		return err
+	}
+	c.conn = conn
+	c.reader = bufio.NewReader(conn)
+
+	greeting, err := c.readLine()
+	if err != nil {
+		c.Close()
+		return err
+	}
+	// "PJLINK 0" means no authentication, "PJLINK 1 <random>" requests the
+	// MD5 digest of random+password ahead of the first command.
+	fields := strings.Fields(greeting)
+	if len(fields) < 2 || fields[0] != "PJLINK" {
+		c.Close()
+		return ErrBadResponse
+	}
+	switch fields[1] {
+	case "0":
+		c.digest = ""
+	case "1":
+		if len(fields) < 3 {
+			c.Close()
+			return ErrBadResponse
+		}
+		sum := md5.Sum([]byte(fields[2] + c.password))
+		c.digest = hex.EncodeToString(sum[:])
+	case "ERRA":
+		c.Close()
+		return ErrAuth
+	default:
+		c.Close()
+		return ErrBadResponse
+	}
+	return nil
+}
+
+func (c *Client) Close() error {
+	if c.conn == nil {
+		return nil
+	}
+	err := c.conn.Close()
+	c.conn = nil
+	c.reader = nil
+	return err
+}
+
+func (c *Client) readLine() (string, error) {
+	c.conn.SetReadDeadline(time.Now().Add(c.timeout))
+	line, err := c.reader.ReadString('\r')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+func (c *Client) send(command, param string) (string, error) {
+	var resp string
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if c.conn == nil {
+			if err = c.connect(); err != nil {
+				return "", err
+			}
+		}
+		resp, err = c.exchange(command, param)
+		if err == nil {
+			break
+		}
+		if _, ok := err.(Error); ok {
+			return "", err
+		}
+		// Most likely the projector dropped an idle connection.
+		c.Close()
+	}
+	if err != nil {
+		return "", err
+	}
+	return resp, nil
+}
+
+func (c *Client) exchange(command, param string) (string, error) {
+	line := c.digest + "%1" + command + " " + param + "\r"
+	c.digest = ""
+	c.conn.SetWriteDeadline(time.Now().Add(c.timeout))
+	if _, err := c.conn.Write([]byte(line)); err != nil {
+		return "", err
+	}
+	resp, err := c.readLine()
+	if err != nil {
+		return "", err
+	}
+	if resp == "PJLINK ERRA" {
+		c.Close()
+		return "", ErrAuth
+	}
+	prefix := "%1" + command + "="
+	if !strings.HasPrefix(resp, prefix) {
+		return "", ErrBadResponse
+	}
+	value := resp[len(prefix):]
+	switch value {
+	case "ERR1":
+		return "", ErrUndefinedCommand
+	case "ERR2":
+		return "", ErrOutOfParameter
+	case "ERR3":
+		return "", ErrUnavailableTime
+	case "ERR4":
+		return "", ErrProjectorFailure
+	case "ERRA":
+		return "", ErrAuth
+	}
+	return value, nil
+}
+
+func (c *Client) set(command, param string) error {
+	resp, err := c.send(command, param)
+	if err != nil {
+		return err
+	}
+	if resp != "OK" {
+		return ErrBadResponse
+	}
+	return nil
+}
+
+func (c *Client) Power() (PowerStatus, error) {
+	resp, err := c.send("POWR", "?")
+	if err != nil {
+		return 0, err
+	}
+	if len(resp) != 1 || resp[0] < '0' || resp[0] > '3' {
+		return 0, ErrBadResponse
+	}
+	return PowerStatus(resp[0] - '0'), nil
+}
+
+// PowerState reports true while the projector is on or warming up.
+func (c *Client) PowerState() (bool, error) {
+	status, err := c.Power()
+	if err != nil {
+		return false, err
+	}
+	return status == POWER_ON || status == POWER_WARMING, nil
+}
+
+func (c *Client) PowerOn() error {
+	return c.set("POWR", "1")
+}
+
+func (c *Client) PowerOff() error {
+	return c.set("POWR", "0")
+}
+
+func (c *Client) Input() (Input, error) {
+	resp, err := c.send("INPT", "?")
+	if err != nil {
+		return "", err
+	}
+	if len(resp) != 2 {
+		return "", ErrBadResponse
+	}
+	return Input(resp), nil
+}
+
+func (c *Client) SetInput(input Input) error {
+	return c.set("INPT", string(input))
+}
+
+func (c *Client) AVMute() (AVMute, error) {
+	resp, err := c.send("AVMT", "?")
+	if err != nil {
+		return AVMute{}, err
+	}
+	switch resp {
+	case "10", "20", "30":
+		return AVMute{}, nil
+	case "11":
+		return AVMute{Video: true}, nil
+	case "21":
+		return AVMute{Audio: true}, nil
+	case "31":
+		return AVMute{Video: true, Audio: true}, nil
+	}
+	return AVMute{}, ErrBadResponse
+}
+
+func (c *Client) SetAVMute(mute AVMute) error {
+	// Class 1 only has combined on/off codes per channel, so send one
+	// command per channel.
+	video := "10"
+	if mute.Video {
+		video = "11"
+	}
+	audio := "20"
+	if mute.Audio {
+		audio = "21"
+	}
+	if err := c.set("AVMT", video); err != nil {
+		return err
+	}
+	return c.set("AVMT", audio)
+}
+
+func (c *Client) Lamps() ([]Lamp, error) {
+	resp, err := c.send("LAMP", "?")
+	if err != nil {
+		return nil, err
+	}
+	fields := strings.Fields(resp)
+	if len(fields) == 0 || len(fields)%2 != 0 {
+		return nil, ErrBadResponse
+	}
+	var lamps []Lamp
+	for i := 0; i < len(fields); i += 2 {
+		hours, err := strconv.ParseUint(fields[i], 10, 32)
+		if err != nil {
+			return nil, ErrBadResponse
+		}
+		lamps = append(lamps, Lamp{Hours: uint32(hours), On: fields[i+1] == "1"})
+	}
+	return lamps, nil
+}
+
+// LampHours returns the hour counter of the first lamp.
+func (c *Client) LampHours() (uint32, error) {
+	lamps, err := c.Lamps()
+	if err != nil {
+		return 0, err
+	}
+	return lamps[0].Hours, nil
+}
+
+func (c *Client) ErrorStatus() (ErrorStatus, error) {
+	resp, err := c.send("ERST", "?")
+	if err != nil {
+		return ErrorStatus{}, err
+	}
+	if len(resp) != 6 {
+		return ErrorStatus{}, ErrBadResponse
+	}
+	var levels [6]ErrorLevel
+	for i := range levels {
+		if resp[i] < '0' || resp[i] > '2' {
+			return ErrorStatus{}, ErrBadResponse
+		}
+		levels[i] = ErrorLevel(resp[i] - '0')
+	}
+	return ErrorStatus{
+		Fan:         levels[0],
+		Lamp:        levels[1],
+		Temperature: levels[2],
+		Cover:       levels[3],
+		Filter:      levels[4],
+		Other:       levels[5],
+	}, nil
+}
+
+func (c *Client) Name() (string, error) {
+	return c.send("NAME", "?")
+}
+
+func (c *Client) Manufacturer() (string, error) {
+	return c.send("INF1", "?")
+}
+
+func (c *Client) ProductName() (string, error) {
+	return c.send("INF2", "?")
+}
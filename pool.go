@@ -0,0 +1,152 @@
+package projector
+
+import (
+	"sync"
+	"time"
+)
+
+// Pool manages connections to many projectors keyed by name. Connections
+// are opened lazily on first checkout and closed after sitting unused for
+// the idle timeout. One Projector is shared by every concurrent checkout of
+// the same name.
+type Pool struct {
+	open func(name string) (*Projector, error)
+	idle time.Duration
+
+	mu      sync.Mutex
+	entries map[string]*poolEntry
+	stop    chan struct{}
+}
+
+type poolEntry struct {
+	projector *Projector
+	err       error
+	ready     chan struct{}
+	users     int
+	lastUsed  time.Time
+}
+
+// NewPool returns a Pool that opens connections with open. An idle timeout
+// of 0 keeps connections open until Close.
+func NewPool(open func(name string) (*Projector, error), idle time.Duration) *Pool {
+	pool := &Pool{open: open, idle: idle, entries: map[string]*poolEntry{}, stop: make(chan struct{})}
+	if idle > 0 {
+		go pool.reap()
+	}
+	return pool
+}
+
+// Get checks out the projector for name, opening it if needed. Every
+// successful Get must be paired with a Put.
+func (pool *Pool) Get(name string) (*Projector, error) {
+	pool.mu.Lock()
+	entry, ok := pool.entries[name]
+	if !ok {
+		entry = &poolEntry{ready: make(chan struct{})}
+		pool.entries[name] = entry
+	}
+	entry.users++
+	pool.mu.Unlock()
+
+	if !ok {
+		p, err := pool.open(name)
+		pool.mu.Lock()
+		entry.projector, entry.err = p, err
+		pool.mu.Unlock()
+		close(entry.ready)
+	}
+	<-entry.ready
+
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if entry.err != nil {
+		entry.users--
+		if pool.entries[name] == entry {
+			delete(pool.entries, name)
+		}
+		return nil, entry.err
+	}
+	return entry.projector, nil
+}
+
+// Put returns a projector checked out with Get.
+func (pool *Pool) Put(name string) {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	if entry, ok := pool.entries[name]; ok && entry.users > 0 {
+		entry.users--
+		entry.lastUsed = time.Now()
+	}
+}
+
+// Do checks out name for the duration of fn.
+func (pool *Pool) Do(name string, fn func(p *Projector) error) error {
+	p, err := pool.Get(name)
+	if err != nil {
+		return err
+	}
+	defer pool.Put(name)
+	return fn(p)
+}
+
+// Names lists the projectors that currently have an open connection.
+func (pool *Pool) Names() []string {
+	pool.mu.Lock()
+	defer pool.mu.Unlock()
+	var names []string
+	for name, entry := range pool.entries {
+		if entry.projector != nil {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Close closes every connection, including ones still checked out.
+func (pool *Pool) Close() error {
+	pool.mu.Lock()
+	select {
+	case <-pool.stop:
+	default:
+		close(pool.stop)
+	}
+	entries := pool.entries
+	pool.entries = map[string]*poolEntry{}
+	pool.mu.Unlock()
+
+	var firstErr error
+	for _, entry := range entries {
+		<-entry.ready
+		if entry.projector == nil {
+			continue
+		}
+		if err := entry.projector.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (pool *Pool) reap() {
+	ticker := time.NewTicker(pool.idle / 2)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-pool.stop:
+			return
+		case <-ticker.C:
+		}
+		var idle []*Projector
+		pool.mu.Lock()
+		for name, entry := range pool.entries {
+			if entry.users == 0 && entry.projector != nil && time.Since(entry.lastUsed) >= pool.idle {
+				idle = append(idle, entry.projector)
+				delete(pool.entries, name)
+			}
+		}
+		pool.mu.Unlock()
+		for _, p := range idle {
+			p.Close()
+		}
+	}
+}
@@ -0,0 +1,108 @@
+package projector
+
+import (
+	"runtime"
+	"strings"
+
+	"go.bug.st/serial/enumerator"
+)
+
+type PortInfo struct {
+	// Name is what to pass to Open, e.g. "/dev/ttyUSB0" or "COM12".
+	Name string
+	// FriendlyName describes the device, e.g. "USB Serial Port (COM12)",
+	// when the platform provides it.
+	FriendlyName string
+	// VID, PID and SerialNumber identify USB adapters, as hex strings like
+	// "0403" and "6001". They are empty for other ports.
+	VID          string
+	PID          string
+	SerialNumber string
+}
+
+// ListPorts returns the serial ports on the host, with USB identifiers
+// filled in for USB adapters.
+func ListPorts() ([]PortInfo, error) {
+	ports, err := listPorts()
+	if err != nil {
+		return nil, err
+	}
+	details, err := enumerator.GetDetailedPortsList()
+	if err != nil {
+		return ports, nil
+	}
+	for i := range ports {
+		for _, detail := range details {
+			if !detail.IsUSB || !samePort(detail.Name, ports[i].Name) {
+				continue
+			}
+			ports[i].VID = strings.ToUpper(detail.VID)
+			ports[i].PID = strings.ToUpper(detail.PID)
+			ports[i].SerialNumber = detail.SerialNumber
+			if ports[i].FriendlyName == "" {
+				ports[i].FriendlyName = detail.Product
+			}
+		}
+	}
+	return ports, nil
+}
+
+func samePort(a, b string) bool {
+	return strings.EqualFold(NormalizePortName(a), NormalizePortName(b))
+}
+
+// USBMatch selects a USB serial adapter. Empty fields match anything.
+type USBMatch struct {
+	VID          string
+	PID          string
+	SerialNumber string
+}
+
+func (m USBMatch) matches(port PortInfo) bool {
+	if port.VID == "" {
+		return false
+	}
+	return (m.VID == "" || strings.EqualFold(m.VID, port.VID)) &&
+		(m.PID == "" || strings.EqualFold(m.PID, port.PID)) &&
+		(m.SerialNumber == "" || m.SerialNumber == port.SerialNumber)
+}
+
+// FindPort returns the name of the first port whose USB adapter matches.
+func FindPort(match USBMatch) (string, error) {
+	ports, err := ListPorts()
+	if err != nil {
+		return "", err
+	}
+	for _, port := range ports {
+		if match.matches(port) {
+			return port.Name, nil
+		}
+	}
+	return "", ProjectorError("No USB serial adapter matches")
+}
+
+// WithUSBMatch binds Open with an empty port name, and Discover, to USB
+// adapters matching m, so deployments with several dongles pick the right
+// one deterministically.
+func WithUSBMatch(m USBMatch) Option {
+	return func(p *Projector) {
+		p.usbMatch = &m
+	}
+}
+
+// NormalizePortName tidies a user-supplied port name. On Windows "com12",
+// "COM12:" and "COM12" all become `\\.\COM12`, the form required for ports
+// above COM9. Elsewhere the name is returned unchanged.
+func NormalizePortName(name string) string {
+	if runtime.GOOS != "windows" {
+		return name
+	}
+	name = strings.TrimSuffix(strings.TrimSpace(name), ":")
+	if strings.HasPrefix(name, `\\.\`) {
+		return name
+	}
+	if len(name) > 3 && strings.EqualFold(name[:3], "COM") {
+		return `\\.\COM` + name[3:]
+	}
+	return name
+}
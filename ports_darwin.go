@@ -0,0 +1,21 @@
+package projector
+
+import (
+	"path/filepath"
+	"strings"
+)
+
+func listPorts() ([]PortInfo, error) {
+	matches, err := filepath.Glob("/dev/cu.*")
+	if err != nil {
+		return nil, err
+	}
+	var ports []PortInfo
+	for _, name := range matches {
+		if strings.Contains(name, "Bluetooth") {
+			continue
+		}
+		ports = append(ports, PortInfo{Name: name, FriendlyName: strings.TrimPrefix(filepath.Base(name), "cu.")})
+	}
+	return ports, nil
+}
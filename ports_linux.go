@@ -0,0 +1,74 @@
+package projector
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+func listPorts() ([]PortInfo, error) {
+	var names []string
+	for _, pattern := range []string{"/dev/ttyUSB*", "/dev/ttyACM*", "/dev/ttyS*", "/dev/ttyAMA*"} {
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range matches {
+			if hasDevice(filepath.Base(name)) {
+				names = append(names, name)
+			}
+		}
+	}
+	sort.Strings(names)
+	var ports []PortInfo
+	for _, name := range names {
+		ports = append(ports, PortInfo{Name: name, FriendlyName: friendlyName(filepath.Base(name))})
+	}
+	return ports, nil
+}
+
+// hasDevice filters out the placeholder ttyS nodes the kernel creates
+// whether or not a UART is behind them.
+func hasDevice(tty string) bool {
+	device := filepath.Join("/sys/class/tty", tty, "device")
+	if _, err := os.Stat(device); err != nil {
+		return false
+	}
+	subsystem, err := filepath.EvalSymlinks(filepath.Join(device, "subsystem"))
+	if err != nil {
+		return true
+	}
+	return filepath.Base(subsystem) != "platform"
+}
+
+// friendlyName reads the USB product string for USB adapters, falling back
+// to the driver name.
+func friendlyName(tty string) string {
+	device, err := filepath.EvalSymlinks(filepath.Join("/sys/class/tty", tty, "device"))
+	if err != nil {
+		return ""
+	}
+	if product := readSysfsUp(device, "product", 3); product != "" {
+		if manufacturer := readSysfsUp(device, "manufacturer", 3); manufacturer != "" {
+			return manufacturer + " " + product
+		}
+		return product
+	}
+	driver, err := filepath.EvalSymlinks(filepath.Join(device, "driver"))
+	if err != nil {
+		return ""
+	}
+	return filepath.Base(driver)
+}
+
+// readSysfsUp looks for file in dir and up to levels of its parents.
+func readSysfsUp(dir, file string, levels int) string {
+	for i := 0; i <= levels; i++ {
+		if b, err := os.ReadFile(filepath.Join(dir, file)); err == nil {
+			return strings.TrimSpace(string(b))
+		}
+		dir = filepath.Dir(dir)
+	}
+	return ""
+}
@@ -0,0 +1,7 @@
+//go:build !linux && !darwin && !windows
+
+package projector
+
+func listPorts() ([]PortInfo, error) {
+	return nil, ProjectorError("Port enumeration not supported on this platform")
+}
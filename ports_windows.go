@@ -0,0 +1,101 @@
+package projector
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/windows/registry"
+)
+
+// Enumerators under HKLM\SYSTEM\CurrentControlSet\Enum that host serial
+// ports: USB adapters, FTDI's own bus driver, and on-board UARTs.
+var portEnumerators = []string{"USB", "FTDIBUS", "ACPI", "PCI", "SERENUM"}
+
+// listPorts reads the active ports from HARDWARE\DEVICEMAP\SERIALCOMM and
+// looks up their Device Manager names under the device enumeration tree.
+func listPorts() ([]PortInfo, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, `HARDWARE\DEVICEMAP\SERIALCOMM`, registry.QUERY_VALUE)
+	if err != nil {
+		if err == registry.ErrNotExist {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer key.Close()
+	values, err := key.ReadValueNames(0)
+	if err != nil {
+		return nil, err
+	}
+	friendly := friendlyNames()
+	var ports []PortInfo
+	for _, value := range values {
+		name, _, err := key.GetStringValue(value)
+		if err != nil {
+			continue
+		}
+		ports = append(ports, PortInfo{Name: name, FriendlyName: friendly[name]})
+	}
+	sort.Slice(ports, func(i, j int) bool {
+		return comNumber(ports[i].Name) < comNumber(ports[j].Name)
+	})
+	return ports, nil
+}
+
+func comNumber(name string) int {
+	n, err := strconv.Atoi(strings.TrimPrefix(strings.ToUpper(name), "COM"))
+	if err != nil {
+		return 1 << 30
+	}
+	return n
+}
+
+// friendlyNames maps "COMn" to the FriendlyName of the device instance
+// whose Device Parameters\PortName is "COMn".
+func friendlyNames() map[string]string {
+	names := map[string]string{}
+	for _, enumerator := range portEnumerators {
+		base := `SYSTEM\CurrentControlSet\Enum\` + enumerator
+		devices, err := subKeys(base)
+		if err != nil {
+			continue
+		}
+		for _, device := range devices {
+			instances, err := subKeys(base + `\` + device)
+			if err != nil {
+				continue
+			}
+			for _, instance := range instances {
+				path := base + `\` + device + `\` + instance
+				port := stringValue(path+`\Device Parameters`, "PortName")
+				if port == "" {
+					continue
+				}
+				names[port] = stringValue(path, "FriendlyName")
+			}
+		}
+	}
+	return names
+}
+
+func subKeys(path string) ([]string, error) {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, path, registry.ENUMERATE_SUB_KEYS)
+	if err != nil {
+		return nil, err
+	}
+	defer key.Close()
+	return key.ReadSubKeyNames(0)
+}
+
+func stringValue(path, name string) string {
+	key, err := registry.OpenKey(registry.LOCAL_MACHINE, path, registry.QUERY_VALUE)
+	if err != nil {
+		return ""
+	}
+	defer key.Close()
+	value, _, err := key.GetStringValue(name)
+	if err != nil {
+		return ""
+	}
+	return value
+}
@@ -0,0 +1,113 @@
+package projector
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// Capabilities is what Probe found the projector to answer.
+type Capabilities struct {
+	// Commands maps each readable registry command to whether the
+	// projector answered it. Commands it rejected for its current state,
+	// e.g. in standby, are left out.
+	Commands map[string]bool
+
+	HasVolume         bool
+	Has3D             bool
+	HasLensControl    bool
+	HasKeystoneH      bool
+	HasBrilliantColor bool
+	// Laser is set when the projector has no lamp to report hours for,
+	// not merely when it declined to report them.
+	Laser bool
+	// Variant is the frame layout the projector answered in.
+	Variant ProtocolVariant
+}
+
+// Has reports whether the projector answered the named command.
+func (c *Capabilities) Has(name string) bool {
+	return c.Commands[name]
+}
+
+// Probe reads every readable command in the registry that the model
+// supports and records which ones the projector answers, after detecting
+// the protocol variant. Only reads are sent, so probing never changes the
+// projector's settings. A command rejected with EXCEPTION_UNSUPPORTED, or
+// that gets no complete reply as on firmware that ignores unknown opcodes,
+// counts as absent. Other exceptions, and replies without a value, are
+// what a projector in standby or warming up sends, so those commands are
+// left out and stay enabled, as are those that fail any other way. Only
+// ErrBusy, a connection error or the end of ctx aborts the probe. Once a probe completes, commands it found absent fail with
+// ErrUnsupported without being sent.
+func (p *Projector) Probe(ctx context.Context) (*Capabilities, error) {
+	p.mu.Lock()
+	p.caps = nil
+	p.mu.Unlock()
+	variant, err := p.detectVariant(ctx)
+	if err != nil {
+		return nil, err
+	}
+	caps := &Capabilities{Commands: map[string]bool{}, Variant: variant}
+	model := p.Model()
+	for _, c := range Commands() {
+		if c.Access&ACCESS_READ == 0 {
+			continue
+		}
+		if !model.Supports(c.Name) {
+			caps.Commands[c.Name] = false
+			continue
+		}
+		_, err := p.get(ctx, c.Name)
+		switch {
+		case err == nil:
+			caps.Commands[c.Name] = true
+		case errors.Is(err, ErrBusy), isConnectionError(err), ctx.Err() != nil:
+			return nil, err
+		case errors.Is(err, ErrUnsupported), errors.Is(err, ErrTimeout), errors.Is(err, ErrTruncatedResponse):
+			caps.Commands[c.Name] = false
+		}
+	}
+
+	caps.HasVolume = caps.Has("volume")
+	caps.Has3D = caps.Has("3d_mode")
+	caps.HasKeystoneH = caps.Has("keystone_h")
+	caps.HasBrilliantColor = caps.Has("brilliant_color")
+	for name, ok := range caps.Commands {
+		if ok && strings.HasPrefix(name, "lens_") {
+			caps.HasLensControl = true
+		}
+	}
+	answered, probed := caps.Commands["lamp_hours"]
+	caps.Laser = probed && !answered
+	p.mu.Lock()
+	p.caps = caps
+	p.mu.Unlock()
+	return caps, nil
+}
+
+// detectVariant checks that the projector answers a power query, switching
+// to the other frame layout if it stays silent in the current one.
+func (p *Projector) detectVariant(ctx context.Context) (ProtocolVariant, error) {
+	current := p.ProtocolVariant()
+	_, err := p.PowerStateContext(ctx)
+	if !errors.Is(err, ErrTimeout) && !errors.Is(err, ErrTruncatedResponse) {
+		return current, err
+	}
+	other := PROTOCOL_LEGACY
+	if current == PROTOCOL_LEGACY {
+		other = PROTOCOL_STANDARD
+	}
+	p.setVariant(other)
+	if _, lerr := p.PowerStateContext(ctx); lerr != nil {
+		p.setVariant(current)
+		return current, err
+	}
+	return other, nil
+}
+
+func (p *Conn) setVariant(v ProtocolVariant) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.variant = v
+}
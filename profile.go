@@ -0,0 +1,164 @@
+package projector
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const TRANSPORT_SERIAL = "serial"
+const TRANSPORT_TCP = "tcp"
+const TRANSPORT_WEBSOCKET = "websocket"
+
+// Profile is a stored set of connection settings for one projector.
+type Profile struct {
+	Name  string `json:"name" yaml:"name"`
+	Label string `json:"label,omitempty" yaml:"label,omitempty"`
+	// Transport is TRANSPORT_SERIAL (the default), TRANSPORT_TCP or
+	// TRANSPORT_WEBSOCKET.
+	Transport string `json:"transport,omitempty" yaml:"transport,omitempty"`
+	// Address is the port name for serial (including the tcp:// and
+	// rfc2217:// forms), host:port for TCP, or the bridge URL.
+	Address     string `json:"address" yaml:"address"`
+	Baud        int    `json:"baud,omitempty" yaml:"baud,omitempty"`
+	DataBits    byte   `json:"data_bits,omitempty" yaml:"data_bits,omitempty"`
+	Parity      string `json:"parity,omitempty" yaml:"parity,omitempty"`
+	StopBits    byte   `json:"stop_bits,omitempty" yaml:"stop_bits,omitempty"`
+	ProjectorID byte   `json:"projector_id,omitempty" yaml:"projector_id,omitempty"`
+	// Model selects a ModelProfile by name or model number, e.g. "LS" or
+	// "PJD7820HD".
+	Model string `json:"model,omitempty" yaml:"model,omitempty"`
+}
+
+type profileFile struct {
+	Profiles []Profile `json:"profiles" yaml:"profiles"`
+}
+
+// Options converts the profile's serial and model settings to Options.
+func (pr Profile) Options() []Option {
+	var opts []Option
+	if pr.Baud > 0 {
+		opts = append(opts, WithBaud(pr.Baud))
+	}
+	if pr.DataBits > 0 {
+		opts = append(opts, WithDataBits(pr.DataBits))
+	}
+	if pr.Parity != "" {
+		opts = append(opts, WithParity(Parity(strings.ToUpper(pr.Parity)[0])))
+	}
+	if pr.StopBits > 0 {
+		opts = append(opts, WithStopBits(StopBits(pr.StopBits)))
+	}
+	if m := ModelFor(pr.Model); m != nil {
+		opts = append(opts, WithModel(m))
+	}
+	return opts
+}
+
+// Open connects using the profile. opts are applied after the profile's
+// own settings.
+func (pr Profile) Open(opts ...Option) (*Projector, error) {
+	opts = append(pr.Options(), opts...)
+	var p *Projector
+	var err error
+	switch pr.Transport {
+	case "", TRANSPORT_SERIAL:
+		p = &Projector{}
+		err = p.Open(pr.Address, opts...)
+	case TRANSPORT_TCP:
+		p, err = DialTCP(pr.Address, opts...)
+	case TRANSPORT_WEBSOCKET:
+		p, err = DialWebSocket(context.Background(), pr.Address, opts...)
+	default:
+		return nil, ProjectorError("Unknown profile transport " + pr.Transport)
+	}
+	if err != nil {
+		return nil, err
+	}
+	p.SetTargetID(pr.ProjectorID)
+	return p, nil
+}
+
+// LoadProfiles reads every profile from path, which is YAML when it ends in
+// .yaml or .yml and JSON otherwise.
+func LoadProfiles(path string) ([]Profile, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var file profileFile
+	if isYAML(path) {
+		err = yaml.Unmarshal(b, &file)
+	} else {
+		err = json.Unmarshal(b, &file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return file.Profiles, nil
+}
+
+// LoadProfile returns the profile called name from path.
+func LoadProfile(path, name string) (*Profile, error) {
+	profiles, err := LoadProfiles(path)
+	if err != nil {
+		return nil, err
+	}
+	for i := range profiles {
+		if profiles[i].Name == name {
+			return &profiles[i], nil
+		}
+	}
+	return nil, ProjectorError("No profile named " + name)
+}
+
+// SaveProfile adds profile to path, replacing any profile with the same
+// name. The file is created if it does not exist.
+func SaveProfile(path string, profile Profile) error {
+	profiles, err := LoadProfiles(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	replaced := false
+	for i := range profiles {
+		if profiles[i].Name == profile.Name {
+			profiles[i] = profile
+			replaced = true
+		}
+	}
+	if !replaced {
+		profiles = append(profiles, profile)
+	}
+
+	file := profileFile{Profiles: profiles}
+	var b []byte
+	if isYAML(path) {
+		b, err = yaml.Marshal(&file)
+	} else {
+		b, err = json.MarshalIndent(&file, "", "  ")
+	}
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, b, 0644)
+}
+
+// OpenProfile loads the profile called name from path and opens it.
+func OpenProfile(path, name string, opts ...Option) (*Projector, error) {
+	profile, err := LoadProfile(path, name)
+	if err != nil {
+		return nil, err
+	}
+	return profile.Open(opts...)
+}
+
+func isYAML(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
@@ -0,0 +1,169 @@
+package projector
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// QueueStats is a snapshot of the command queue.
+type QueueStats struct {
+	// Depth is the number of commands waiting, not counting the one in
+	// flight.
+	Depth int
+	// MaxDepth is the deepest the queue has been.
+	MaxDepth int
+	InFlight bool
+	// Processed counts commands that ran to completion, successfully or not.
+	Processed uint64
+	// Abandoned counts commands whose context ended before they started.
+	Abandoned uint64
+}
+
+// WithCommandTimeout bounds how long each command may take once it leaves
+// the queue, including retries and reconnects. Zero means no limit beyond
+// the caller's context.
+func WithCommandTimeout(d time.Duration) Option {
+	return func(p *Projector) {
+		p.timeout = d
+	}
+}
+
+// DefaultResponseTimeout is how long the projector is given to finish a
+// reply unless WithResponseTimeout says otherwise.
+const DefaultResponseTimeout = 2 * time.Second
+
+// WithResponseTimeout sets how long to wait for a complete reply, however
+// many serial read timeouts that spans.
+func WithResponseTimeout(d time.Duration) Option {
+	return func(p *Projector) {
+		p.response = d
+	}
+}
+
+type responseTimeoutKey struct{}
+
+// withResponseTimeout overrides the response timeout for commands run with
+// the returned context. Zero leaves ctx unchanged.
+func withResponseTimeout(ctx context.Context, d time.Duration) context.Context {
+	if d <= 0 {
+		return ctx
+	}
+	return context.WithValue(ctx, responseTimeoutKey{}, d)
+}
+
+func (p *Conn) responseTimeout(ctx context.Context) time.Duration {
+	if d, ok := ctx.Value(responseTimeoutKey{}).(time.Duration); ok {
+		return d
+	}
+	if p.response > 0 {
+		return p.response
+	}
+	return DefaultResponseTimeout
+}
+
+type job struct {
+	ctx  context.Context
+	run  func(ctx context.Context)
+	done chan struct{}
+}
+
+// commandQueue holds commands in FIFO order. A worker goroutine is started
+// when the first command arrives and exits once the queue is empty.
+type commandQueue struct {
+	mu      sync.Mutex
+	pending []*job
+	running bool
+	stats   QueueStats
+}
+
+// QueueStats reports the current state of the command queue.
+func (p *Conn) QueueStats() QueueStats {
+	p.queue.mu.Lock()
+	defer p.queue.mu.Unlock()
+	stats := p.queue.stats
+	stats.Depth = len(p.queue.pending)
+	return stats
+}
+
+// submit queues fn and waits for the worker to run it. fn runs with p.mu
+// held and owns the port until it returns. If ctx ends while fn is still
+// queued it is dropped and ctx.Err() is returned.
+func (p *Conn) submit(ctx context.Context, fn func(ctx context.Context) error) error {
+	var err error
+	j := &job{ctx: ctx, done: make(chan struct{})}
+	j.run = func(ctx context.Context) {
+		if err = ctx.Err(); err == nil {
+			err = fn(ctx)
+		}
+	}
+
+	q := &p.queue
+	q.mu.Lock()
+	q.pending = append(q.pending, j)
+	if len(q.pending) > q.stats.MaxDepth {
+		q.stats.MaxDepth = len(q.pending)
+	}
+	if !q.running {
+		q.running = true
+		go p.work()
+	}
+	q.mu.Unlock()
+
+	select {
+	case <-j.done:
+		return err
+	case <-ctx.Done():
+		if q.remove(j) {
+			return ctx.Err()
+		}
+		<-j.done
+		return err
+	}
+}
+
+func (p *Conn) work() {
+	q := &p.queue
+	for {
+		q.mu.Lock()
+		if len(q.pending) == 0 {
+			q.running = false
+			q.mu.Unlock()
+			return
+		}
+		j := q.pending[0]
+		q.pending[0] = nil
+		q.pending = q.pending[1:]
+		q.stats.InFlight = true
+		q.mu.Unlock()
+
+		p.mu.Lock()
+		ctx, cancel := j.ctx, context.CancelFunc(func() {})
+		if p.timeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		}
+		j.run(ctx)
+		cancel()
+		p.mu.Unlock()
+
+		q.mu.Lock()
+		q.stats.InFlight = false
+		q.stats.Processed++
+		q.mu.Unlock()
+		close(j.done)
+	}
+}
+
+// remove drops j if it has not started yet.
+func (q *commandQueue) remove(j *job) bool {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	for i, pending := range q.pending {
+		if pending == j {
+			q.pending = append(q.pending[:i], q.pending[i+1:]...)
+			q.stats.Abandoned++
+			return true
+		}
+	}
+	return false
+}
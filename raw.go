@@ -0,0 +1,32 @@
+package projector
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+)
+
+// SendRaw frames data as a packet of type cmdType, sends it and returns the
+// reply, for functions in the ViewSonic command tables that have no wrapper
+// here. data is the payload only, e.g. 34 00 00 11 00 for a power query.
+func (p *Conn) SendRaw(cmdType CommandType, data []byte) (*Packet, error) {
+	return p.SendRawContext(context.Background(), cmdType, data)
+}
+
+func (p *Conn) SendRawContext(ctx context.Context, cmdType CommandType, data []byte) (*Packet, error) {
+	return p.WriteAndReadContext(ctx, Packet{Command: cmdType, Data: data})
+}
+
+// SendRawHex is SendRaw with the payload given as hex, e.g. "34 00 00 11 00".
+// Spaces, colons and dashes between bytes are ignored.
+func (p *Conn) SendRawHex(cmdType CommandType, data string) (*Packet, error) {
+	return p.SendRawHexContext(context.Background(), cmdType, data)
+}
+
+func (p *Conn) SendRawHexContext(ctx context.Context, cmdType CommandType, data string) (*Packet, error) {
+	b, err := hex.DecodeString(strings.NewReplacer(" ", "", ":", "", "-", "").Replace(data))
+	if err != nil {
+		return nil, err
+	}
+	return p.SendRawContext(ctx, cmdType, b)
+}
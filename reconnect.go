@@ -0,0 +1,127 @@
+package projector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"time"
+)
+
+type ConnState int
+
+const STATE_DISCONNECTED ConnState = 0
+const STATE_CONNECTING ConnState = 1
+const STATE_CONNECTED ConnState = 2
+
+func (s ConnState) String() string {
+	switch s {
+	case STATE_DISCONNECTED:
+		return "disconnected"
+	case STATE_CONNECTING:
+		return "connecting"
+	case STATE_CONNECTED:
+		return "connected"
+	}
+	return "unknown"
+}
+
+// ReconnectPolicy is an exponential backoff. Zero fields take the defaults
+// noted below.
+type ReconnectPolicy struct {
+	// InitialDelay before the second attempt, default 250ms.
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts, default 10s.
+	MaxDelay time.Duration
+	// Multiplier grows the delay after each failed attempt, default 2.
+	Multiplier float64
+	// MaxAttempts per outage, default 5. Negative means no limit.
+	MaxAttempts int
+}
+
+func (r *ReconnectPolicy) delays() (time.Duration, time.Duration, float64, int) {
+	initial, max, mult, attempts := r.InitialDelay, r.MaxDelay, r.Multiplier, r.MaxAttempts
+	if initial <= 0 {
+		initial = time.Millisecond * 250
+	}
+	if max <= 0 {
+		max = time.Second * 10
+	}
+	if mult < 1 {
+		mult = 2
+	}
+	if attempts == 0 {
+		attempts = 5
+	}
+	return initial, max, mult, attempts
+}
+
+// State returns the current connection state.
+func (p *Conn) State() ConnState {
+	return ConnState(p.state.Load())
+}
+
+func (p *Conn) setState(state ConnState) {
+	if ConnState(p.state.Swap(int32(state))) == state {
+		return
+	}
+	if p.OnStateChange != nil {
+		p.OnStateChange(state)
+	}
+}
+
+// reconnect reopens the port using the function remembered by attach,
+// backing off between failed attempts.
+func (p *Conn) reconnect(ctx context.Context) error {
+	if p.Port != nil {
+		p.Port.Close()
+		p.Port = nil
+	}
+	delay, max, mult, attempts := p.AutoReconnect.delays()
+	for attempt := 1; ; attempt++ {
+		p.setState(STATE_CONNECTING)
+		port, err := p.reopen()
+		if err == nil {
+			p.Port = port
+			p.setState(STATE_CONNECTED)
+			return nil
+		}
+		if attempts > 0 && attempt >= attempts {
+			p.setState(STATE_DISCONNECTED)
+			return err
+		}
+		if err := sleepContext(ctx, delay); err != nil {
+			p.setState(STATE_DISCONNECTED)
+			return err
+		}
+		delay = time.Duration(float64(delay) * mult)
+		if delay > max {
+			delay = max
+		}
+	}
+}
+
+// isConnectionError reports whether err came from the transport rather
+// than from the protocol. A serial read timeout surfaces as ErrTimeout, or
+// ErrTruncatedResponse mid-frame, and does not count; a peer closing a
+// network link does.
+func isConnectionError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if errors.Is(err, net.ErrClosed) {
+		return true
+	}
+	var projectorErr ProjectorError
+	if errors.As(err, &projectorErr) {
+		return projectorErr == ErrPortNotOpen || projectorErr == ErrConnectionClosed
+	}
+	var protocolErr *ProtocolError
+	var mismatchErr *MismatchError
+	var sizeErr *PayloadSizeError
+	if errors.Is(err, ErrException) || errors.Is(err, ErrChecksum) || errors.As(err, &protocolErr) ||
+		errors.As(err, &mismatchErr) || errors.As(err, &sizeErr) {
+		return false
+	}
+	return !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF)
+}
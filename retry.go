@@ -0,0 +1,116 @@
+package projector
+
+import (
+	"context"
+	"errors"
+	"io"
+	"time"
+)
+
+// RetryClass is a set of failure kinds a RetryPolicy repeats the exchange
+// for.
+type RetryClass int
+
+const RETRY_CHECKSUM RetryClass = 1 << 0
+const RETRY_TIMEOUT RetryClass = 1 << 1
+const RETRY_PROTOCOL RetryClass = 1 << 2
+const RETRY_EXCEPTION RetryClass = 1 << 3
+
+// RetryPolicy governs how WriteAndRead handles transient failures on a
+// noisy line. Zero fields take the value from DefaultRetryPolicy.
+//
+// Reads, and writes that never reached the wire or that the projector
+// rejected, are always repeatable. Other writes may already have been
+// applied, so they are only repeated for registry commands marked
+// Idempotent; a lost ACK on VolumeUp must not raise the volume twice.
+type RetryPolicy struct {
+	// MaxAttempts counts the first try; 1 disables retries.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for each
+	// further one.
+	Backoff time.Duration
+	RetryOn RetryClass
+}
+
+// DefaultRetryPolicy retries checksum mismatches and timeouts twice.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     time.Millisecond * 50,
+	RetryOn:     RETRY_CHECKSUM | RETRY_TIMEOUT,
+}
+
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(p *Projector) {
+		p.retry = &policy
+	}
+}
+
+func (p *Conn) retryPolicy() RetryPolicy {
+	policy := DefaultRetryPolicy
+	if p.retry != nil {
+		if p.retry.MaxAttempts > 0 {
+			policy.MaxAttempts = p.retry.MaxAttempts
+		}
+		if p.retry.Backoff > 0 {
+			policy.Backoff = p.retry.Backoff
+		}
+		if p.retry.RetryOn != 0 {
+			policy.RetryOn = p.retry.RetryOn
+		}
+	}
+	if p.bus != nil {
+		policy.MaxAttempts = p.bus.retries(p.target) + 1
+		policy.Backoff = 0
+	}
+	return policy
+}
+
+func retryClass(err error) RetryClass {
+	var protocolErr *ProtocolError
+	var mismatchErr *MismatchError
+	switch {
+	case errors.Is(err, ErrException):
+		return RETRY_EXCEPTION
+	case errors.As(err, &protocolErr), errors.As(err, &mismatchErr):
+		return RETRY_PROTOCOL
+	case errors.Is(err, ErrChecksum):
+		return RETRY_CHECKSUM
+	case errors.Is(err, ErrTimeout), errors.Is(err, ErrTruncatedResponse),
+		errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return RETRY_TIMEOUT
+	}
+	return 0
+}
+
+// exchangeWithRetry runs exchange under the retry policy. Callers hold p.mu.
+func (p *Conn) exchangeWithRetry(ctx context.Context, packet Packet) (*Packet, bool, error) {
+	policy := p.retryPolicy()
+	delay := policy.Backoff
+	rPacket, sent, err := p.exchange(ctx, packet)
+	for attempt := 1; attempt < policy.MaxAttempts && err != nil && policy.RetryOn&retryClass(err) != 0; attempt++ {
+		if !repeatable(ctx, packet, sent, err) {
+			break
+		}
+		if p.bus != nil {
+			delay = p.bus.Turnaround
+		}
+		if serr := sleepContext(ctx, delay); serr != nil {
+			return nil, sent, serr
+		}
+		delay *= 2
+		var retrySent bool
+		rPacket, retrySent, err = p.exchange(ctx, packet)
+		sent = sent || retrySent
+	}
+	return rPacket, sent, err
+}
+
+// repeatable reports whether packet may be sent again after failing with
+// err.
+func repeatable(ctx context.Context, packet Packet, sent bool, err error) bool {
+	if !sent || packet.Command == COMMAND_READ || errors.Is(err, ErrException) {
+		return true
+	}
+	c, ok := specFor(ctx, packet)
+	return ok && c.Idempotent
+}
@@ -0,0 +1,230 @@
+package projector
+
+import (
+	"encoding/binary"
+	"net"
+)
+
+// RFC 2217 Ref: https://datatracker.ietf.org/doc/html/rfc2217
+
+const (
+	telnetSE   = 240
+	telnetSB   = 250
+	telnetWILL = 251
+	telnetWONT = 252
+	telnetDO   = 253
+	telnetDONT = 254
+	telnetIAC  = 255
+
+	telnetBinary  = 0
+	telnetSGA     = 3
+	telnetComPort = 44
+
+	comPortSetBaudRate = 1
+	comPortSetDataSize = 2
+	comPortSetParity   = 3
+	comPortSetStopSize = 4
+	comPortPurgeData   = 12
+)
+
+const (
+	telnetStateData = iota
+	telnetStateIAC
+	telnetStateOption
+	telnetStateSub
+	telnetStateSubIAC
+)
+
+// rfc2217Transport speaks telnet with the COM-PORT-OPTION extension on top of
+// a TCP connection, as offered by ser2net's telnet mode and most
+// serial-to-Ethernet adapters.
+type rfc2217Transport struct {
+	tcp    *tcpTransport
+	config SerialConfig
+
+	state   int
+	command byte
+	// pending holds decoded data bytes not yet returned by Read.
+	pending []byte
+}
+
+func dialRFC2217(addr string, config SerialConfig) (*rfc2217Transport, error) {
+	t := &rfc2217Transport{config: config}
+	t.tcp = &tcpTransport{addr: addr, timeout: DefaultConnectTimeout, readTimeout: config.ReadTimeout, handshake: t.negotiate}
+	if err := t.tcp.connect(); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *rfc2217Transport) negotiate(conn net.Conn) error {
+	t.state = telnetStateData
+	t.pending = nil
+
+	msg := []byte{
+		telnetIAC, telnetWILL, telnetBinary,
+		telnetIAC, telnetDO, telnetBinary,
+		telnetIAC, telnetWILL, telnetComPort,
+	}
+	baud := make([]byte, 4)
+	binary.BigEndian.PutUint32(baud, uint32(t.config.Baud))
+	msg = append(msg, comPortCommand(comPortSetBaudRate, baud...)...)
+	msg = append(msg, comPortCommand(comPortSetDataSize, t.config.DataBits)...)
+	msg = append(msg, comPortCommand(comPortSetParity, comPortParity(t.config.Parity))...)
+	msg = append(msg, comPortCommand(comPortSetStopSize, comPortStopSize(t.config.StopBits))...)
+	_, err := conn.Write(msg)
+	return err
+}
+
+func comPortCommand(command byte, value ...byte) []byte {
+	msg := []byte{telnetIAC, telnetSB, telnetComPort, command}
+	msg = append(msg, escapeIAC(value)...)
+	return append(msg, telnetIAC, telnetSE)
+}
+
+func comPortParity(parity Parity) byte {
+	switch parity {
+	case PARITY_ODD:
+		return 2
+	case PARITY_EVEN:
+		return 3
+	case PARITY_MARK:
+		return 4
+	case PARITY_SPACE:
+		return 5
+	}
+	return 1
+}
+
+func comPortStopSize(stopBits StopBits) byte {
+	switch stopBits {
+	case STOP_BITS_2:
+		return 2
+	case STOP_BITS_1_5:
+		return 3
+	}
+	return 1
+}
+
+func escapeIAC(data []byte) []byte {
+	var escaped []byte
+	for _, b := range data {
+		escaped = append(escaped, b)
+		if b == telnetIAC {
+			escaped = append(escaped, telnetIAC)
+		}
+	}
+	return escaped
+}
+
+func (t *rfc2217Transport) Read(b []byte) (int, error) {
+	if len(t.pending) == 0 {
+		buffer := make([]byte, 256)
+		n, err := t.tcp.Read(buffer)
+		if err != nil {
+			return 0, err
+		}
+		if err = t.decode(buffer[:n]); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(b, t.pending)
+	t.pending = t.pending[n:]
+	return n, nil
+}
+
+// decode strips telnet commands from raw and appends the data bytes to
+// pending, answering option negotiation as it goes.
+func (t *rfc2217Transport) decode(raw []byte) error {
+	var reply []byte
+	for _, b := range raw {
+		switch t.state {
+		case telnetStateData:
+			if b == telnetIAC {
+				t.state = telnetStateIAC
+			} else {
+				t.pending = append(t.pending, b)
+			}
+		case telnetStateIAC:
+			switch b {
+			case telnetIAC:
+				t.pending = append(t.pending, b)
+				t.state = telnetStateData
+			case telnetWILL, telnetWONT, telnetDO, telnetDONT:
+				t.command = b
+				t.state = telnetStateOption
+			case telnetSB:
+				t.state = telnetStateSub
+			default:
+				t.state = telnetStateData
+			}
+		case telnetStateOption:
+			reply = append(reply, negotiationReply(t.command, b)...)
+			t.state = telnetStateData
+		case telnetStateSub:
+			// Server acknowledgements of COM-PORT settings are ignored.
+			if b == telnetIAC {
+				t.state = telnetStateSubIAC
+			}
+		case telnetStateSubIAC:
+			if b == telnetSE {
+				t.state = telnetStateData
+			} else {
+				t.state = telnetStateSub
+			}
+		}
+	}
+	if len(reply) > 0 {
+		if _, err := t.tcp.Write(reply); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func negotiationReply(command, option byte) []byte {
+	supported := option == telnetBinary || option == telnetSGA || option == telnetComPort
+	switch command {
+	case telnetDO:
+		if supported {
+			// Already announced with WILL during negotiate.
+			if option == telnetSGA {
+				return []byte{telnetIAC, telnetWILL, option}
+			}
+			return nil
+		}
+		return []byte{telnetIAC, telnetWONT, option}
+	case telnetWILL:
+		if supported {
+			if option == telnetSGA {
+				return []byte{telnetIAC, telnetDO, option}
+			}
+			return nil
+		}
+		return []byte{telnetIAC, telnetDONT, option}
+	}
+	return nil
+}
+
+func (t *rfc2217Transport) Write(b []byte) (int, error) {
+	if _, err := t.tcp.Write(escapeIAC(b)); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (t *rfc2217Transport) Flush() error {
+	t.pending = nil
+	if _, err := t.tcp.Write(comPortCommand(comPortPurgeData, 1)); err != nil {
+		return err
+	}
+	if err := t.tcp.Flush(); err != nil {
+		return err
+	}
+	t.state = telnetStateData
+	return nil
+}
+
+func (t *rfc2217Transport) Close() error {
+	return t.tcp.Close()
+}
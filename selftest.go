@@ -0,0 +1,151 @@
+package projector
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// SelfTestStep is the outcome of one exchange in a self test.
+type SelfTestStep struct {
+	Name string
+	// Request and Response are the bytes sent and received.
+	Request  []byte
+	Response []byte
+	Latency  time.Duration
+	// Err is the exchange's error. An exception reply is a well-formed
+	// answer, so it is recorded here without failing the step.
+	Err    error
+	Passed bool
+}
+
+// SelfTestReport is the result of SelfTest.
+type SelfTestReport struct {
+	Variant ProtocolVariant
+	Steps   []SelfTestStep
+	// RejectsBadChecksum reports whether the projector answered a frame
+	// with a corrupt checksum with an exception rather than ignoring it.
+	RejectsBadChecksum bool
+	// Passed is set if every step passed.
+	Passed bool
+}
+
+// SelfTest qualifies the attached device and cable: it reads every
+// registry command the model supports, repeats the power query to check
+// the replies are stable, and sends a power query with a corrupt checksum,
+// which must be ignored or rejected with an exception. Each step records
+// the wire traffic and how long the reply took. Only reads are sent, so the
+// projector's settings are left alone. err is only set if the test could
+// not run, e.g. because ctx ended while it was queued.
+func (p *Projector) SelfTest(ctx context.Context) (*SelfTestReport, error) {
+	model := p.Model()
+	var reads []Command
+	for _, c := range Commands() {
+		if c.Access&ACCESS_READ == 0 || !model.Supports(c.Name) {
+			continue
+		}
+		if c, err := model.Command(c.Name); err == nil {
+			reads = append(reads, c)
+		}
+	}
+	power, err := model.Command("power")
+	if err != nil {
+		return nil, err
+	}
+	query, err := power.ReadPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	report := &SelfTestReport{Passed: true}
+	err = p.submit(ctx, func(ctx context.Context) error {
+		report.Variant = p.variant
+		for _, c := range reads {
+			packet, _ := c.ReadPacket()
+			step, _ := p.selfTestRead(ctx, "read "+c.Name, c, packet)
+			report.add(step)
+		}
+		var first []byte
+		for i := 0; i < 3; i++ {
+			step, reply := p.selfTestRead(ctx, "repeat power", power, query)
+			if reply != nil {
+				if first == nil {
+					first = reply.Value()
+				} else if string(reply.Value()) != string(first) {
+					step.Err, step.Passed = ProjectorError("Replies differ"), false
+				}
+			}
+			report.add(step)
+		}
+		step := p.selfTestChecksum(ctx, query)
+		report.RejectsBadChecksum = errors.Is(step.Err, ErrException)
+		report.add(step)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func (r *SelfTestReport) add(step SelfTestStep) {
+	r.Steps = append(r.Steps, step)
+	r.Passed = r.Passed && step.Passed
+}
+
+// selfTestRead runs one query. Callers hold p.mu.
+func (p *Conn) selfTestRead(ctx context.Context, name string, c Command, packet Packet) (SelfTestStep, *Packet) {
+	start := time.Now()
+	reply, err := p.writeAndRead(withSpec(ctx, c), packet)
+	step := SelfTestStep{Name: name, Request: p.sent, Response: p.received, Latency: time.Since(start), Err: err}
+	if err == nil {
+		_, err = c.Decode(reply)
+		step.Err = err
+	}
+	step.Passed = err == nil || errors.Is(err, ErrException)
+	return step, reply
+}
+
+// selfTestChecksum sends packet with its checksum inverted. Silence or an
+// exception passes; a normal reply means the projector does not check.
+// Callers hold p.mu.
+func (p *Conn) selfTestChecksum(ctx context.Context, packet Packet) SelfTestStep {
+	step := SelfTestStep{Name: "corrupt checksum"}
+	p.sent, p.received = nil, nil
+	if p.Port == nil {
+		step.Err = ErrPortNotOpen
+		return step
+	}
+	if packet.ID == 0 {
+		packet.ID = p.target
+	}
+	raw := p.variant.build(packet, p.checksum)
+	raw[len(raw)-1] ^= 0xff
+	start := time.Now()
+	err := p.Port.Flush()
+	if err == nil {
+		err = p.drain(ctx)
+	}
+	if err == nil {
+		p.trace(">", raw, &packet, nil)
+		err = p.writeRaw(ctx, raw)
+	}
+	var reply *Packet
+	if err == nil {
+		reply, err = p.readResponse(ctx)
+	}
+	// A late reply must not be taken for the next command's.
+	p.dirty = true
+	step.Request, step.Response, step.Latency = raw, p.received, time.Since(start)
+	switch {
+	case err == nil && reply.Command == COMMAND_EXCEPTION:
+		step.Err, step.Passed = newExceptionError(reply), true
+	case err == nil:
+		step.Err = ProjectorError("Corrupt frame was accepted")
+	case errors.Is(err, ErrTimeout):
+		step.Passed = true
+	default:
+		step.Err = err
+	}
+	return step
+}
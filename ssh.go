@@ -0,0 +1,138 @@
+package projector
+
+import (
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ssh"
+)
+
+type sshTransport struct {
+	client  *ssh.Client
+	session *ssh.Session
+	stdin   io.WriteCloser
+	reader  *asyncReader
+}
+
+// DialSSH logs into addr ("host:22") and attaches to the serial device on
+// that host by running stty and cat there, so the projector can be
+// controlled without exposing a raw TCP port. The remote host needs a
+// GNU stty.
+func DialSSH(addr string, config *ssh.ClientConfig, device string, opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	command := sttyPipeline(device, p.SerialConfig())
+	return dialSSH(p, addr, config, command)
+}
+
+// DialSSHCommand is DialSSH for a custom remote helper: command must relay
+// its stdin to the projector and the projector's output to its stdout.
+func DialSSHCommand(addr string, config *ssh.ClientConfig, command string, opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	return dialSSH(p, addr, config, command)
+}
+
+func dialSSH(p *Projector, addr string, config *ssh.ClientConfig, command string) (*Projector, error) {
+	sshConfig := *config
+	if sshConfig.Timeout == 0 {
+		sshConfig.Timeout = DefaultConnectTimeout
+	}
+	readTimeout := p.SerialConfig().ReadTimeout
+	err := p.attach(func() (Transport, error) {
+		return dialSSHTransport(addr, &sshConfig, command, readTimeout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func dialSSHTransport(addr string, config *ssh.ClientConfig, command string, readTimeout time.Duration) (*sshTransport, error) {
+	client, err := ssh.Dial("tcp", addr, config)
+	if err != nil {
+		return nil, err
+	}
+	session, err := client.NewSession()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	stdin, err := session.StdinPipe()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	stdout, err := session.StdoutPipe()
+	if err != nil {
+		client.Close()
+		return nil, err
+	}
+	if err = session.Start(command); err != nil {
+		client.Close()
+		return nil, err
+	}
+	t := &sshTransport{client: client, session: session, stdin: stdin}
+	t.reader = newAsyncReader(func() ([]byte, error) {
+		buffer := make([]byte, 256)
+		n, err := stdout.Read(buffer)
+		return buffer[:n], err
+	}, readTimeout)
+	return t, nil
+}
+
+// sttyPipeline configures device and relays it in both directions, killing
+// the reader once stdin closes so the device is not left open.
+func sttyPipeline(device string, config SerialConfig) string {
+	dev := shellQuote(device)
+	settings := []string{strconv.Itoa(config.Baud), "cs" + strconv.Itoa(int(config.DataBits)), "raw", "-echo"}
+	switch config.Parity {
+	case PARITY_ODD:
+		settings = append(settings, "parenb", "parodd", "-cmspar")
+	case PARITY_EVEN:
+		settings = append(settings, "parenb", "-parodd", "-cmspar")
+	case PARITY_MARK:
+		settings = append(settings, "parenb", "parodd", "cmspar")
+	case PARITY_SPACE:
+		settings = append(settings, "parenb", "-parodd", "cmspar")
+	default:
+		settings = append(settings, "-parenb")
+	}
+	if config.StopBits == STOP_BITS_2 {
+		settings = append(settings, "cstopb")
+	} else {
+		settings = append(settings, "-cstopb")
+	}
+	return "stty -F " + dev + " " + strings.Join(settings, " ") +
+		" && { cat " + dev + " & pid=$!; cat > " + dev + "; kill $pid; }"
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+func (t *sshTransport) Read(b []byte) (int, error) {
+	return t.reader.Read(b)
+}
+
+func (t *sshTransport) Write(b []byte) (int, error) {
+	return t.stdin.Write(b)
+}
+
+func (t *sshTransport) Buffered() int {
+	return t.reader.Buffered()
+}
+
+func (t *sshTransport) Flush() error {
+	t.reader.flush()
+	return nil
+}
+
+func (t *sshTransport) Close() error {
+	t.reader.close()
+	t.stdin.Close()
+	t.session.Close()
+	return t.client.Close()
+}
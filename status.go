@@ -0,0 +1,57 @@
+package projector
+
+import (
+	"context"
+	"errors"
+)
+
+// Status is a snapshot of the projector's state for dashboards.
+type Status struct {
+	Power     bool
+	LampHours uint32
+	// LampHours2 and ActiveLamp are only set on dual-lamp models, where
+	// ActiveLamp is 1 or 2.
+	LampHours2 uint32
+	ActiveLamp int
+	Muted      bool
+	Frozen     bool
+}
+
+// Status reads the projector's state in one pass.
+func (p *Projector) Status() (*Status, error) {
+	return p.StatusContext(context.Background())
+}
+
+// StatusContext is Status bounded by ctx. Fields the model does not support,
+// or that the projector rejects in its current state, are left zero, as are
+// fields other than Power the projector does not answer for. Any other
+// failure, ErrBusy included, is returned.
+func (p *Projector) StatusContext(ctx context.Context) (*Status, error) {
+	s := &Status{}
+	reads := []struct {
+		name     string
+		optional bool
+		set      func(v int)
+	}{
+		{"power", false, func(v int) { s.Power = v != 0 }},
+		{"lamp_hours", true, func(v int) { s.LampHours = uint32(v) }},
+		{"lamp_hours_2", true, func(v int) { s.LampHours2 = uint32(v) }},
+		{"active_lamp", true, func(v int) { s.ActiveLamp = v }},
+		{"mute", true, func(v int) { s.Muted = v != 0 }},
+		{"freeze", true, func(v int) { s.Frozen = v != 0 }},
+	}
+	for _, r := range reads {
+		v, err := p.get(ctx, r.name)
+		if !errors.Is(err, ErrBusy) && (errors.Is(err, ErrUnsupported) || errors.Is(err, ErrException)) {
+			continue
+		}
+		if r.optional && errors.Is(err, ErrTimeout) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		r.set(v)
+	}
+	return s, nil
+}
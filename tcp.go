@@ -0,0 +1,230 @@
+package projector
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"time"
+)
+
+const DefaultConnectTimeout = time.Second * 5
+
+const tcpFlushTimeout = time.Millisecond * 10
+
+// tcpTransport carries the RS-232 protocol over the projector's LAN control
+// port. A dropped connection is redialled on the next Read or Write.
+type tcpTransport struct {
+	addr        string
+	timeout     time.Duration
+	readTimeout time.Duration
+	conn        net.Conn
+	// tls, if set, wraps every connection in TLS.
+	tls *tls.Config
+	// handshake, if set, runs on every freshly dialled connection.
+	handshake func(conn net.Conn) error
+}
+
+// DialTCP connects to the LAN control port of a projector, e.g.
+// "192.168.1.20:4661".
+func DialTCP(addr string, opts ...Option) (*Projector, error) {
+	return DialTCPTimeout(addr, DefaultConnectTimeout, opts...)
+}
+
+func DialTCPTimeout(addr string, timeout time.Duration, opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	readTimeout := p.SerialConfig().ReadTimeout
+	err := p.attach(func() (Transport, error) {
+		return dialTCPTransport(addr, timeout, readTimeout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DialContext is DialTCP with ctx bounding the connect and an initial power
+// state probe that confirms a projector is answering.
+func DialContext(ctx context.Context, addr string, opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	readTimeout := p.SerialConfig().ReadTimeout
+	err := p.attachContext(ctx, func(ctx context.Context) (Transport, error) {
+		return dialTCPTransportContext(ctx, addr, DefaultConnectTimeout, readTimeout)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DialTLS is DialTCP over TLS, for control links that must be encrypted.
+// config supplies the usual server verification and client certificates;
+// ServerName defaults to the host in addr.
+func DialTLS(addr string, config *tls.Config, opts ...Option) (*Projector, error) {
+	tlsConfig := config.Clone()
+	if tlsConfig == nil {
+		tlsConfig = &tls.Config{}
+	}
+	if tlsConfig.ServerName == "" {
+		host, _, err := net.SplitHostPort(addr)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.ServerName = host
+	}
+	p := &Projector{}
+	p.apply(opts)
+	readTimeout := p.SerialConfig().ReadTimeout
+	err := p.attach(func() (Transport, error) {
+		t := &tcpTransport{addr: addr, timeout: DefaultConnectTimeout, readTimeout: readTimeout, tls: tlsConfig}
+		if err := t.connect(); err != nil {
+			return nil, err
+		}
+		return t, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func dialTCPTransport(addr string, timeout, readTimeout time.Duration) (*tcpTransport, error) {
+	return dialTCPTransportContext(context.Background(), addr, timeout, readTimeout)
+}
+
+func dialTCPTransportContext(ctx context.Context, addr string, timeout, readTimeout time.Duration) (*tcpTransport, error) {
+	t := &tcpTransport{addr: addr, timeout: timeout, readTimeout: readTimeout}
+	if err := t.connectContext(ctx); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *tcpTransport) connect() error {
+	return t.connectContext(context.Background())
+}
+
+func (t *tcpTransport) connectContext(ctx context.Context) error {
+	t.drop()
+	dialer := net.Dialer{Timeout: t.timeout}
+	conn, err := dialer.DialContext(ctx, "tcp", t.addr)
+	if err != nil {
+		return err
+	}
+	if tcpConn, ok := conn.(*net.TCPConn); ok {
+		tcpConn.SetNoDelay(true)
+		tcpConn.SetKeepAlive(true)
+	}
+	if t.tls != nil {
+		tlsConn := tls.Client(conn, t.tls)
+		handshakeCtx, cancel := context.WithTimeout(ctx, t.timeout)
+		err = tlsConn.HandshakeContext(handshakeCtx)
+		cancel()
+		if err != nil {
+			conn.Close()
+			return err
+		}
+		conn = tlsConn
+	}
+	if t.handshake != nil {
+		conn.SetDeadline(time.Now().Add(t.timeout))
+		if err := t.handshake(conn); err != nil {
+			conn.Close()
+			return err
+		}
+		conn.SetDeadline(time.Time{})
+	}
+	t.conn = conn
+	return nil
+}
+
+func (t *tcpTransport) drop() {
+	if t.conn != nil {
+		t.conn.Close()
+		t.conn = nil
+	}
+}
+
+func (t *tcpTransport) Read(b []byte) (int, error) {
+	if t.conn == nil {
+		if err := t.connect(); err != nil {
+			return 0, err
+		}
+	}
+	t.conn.SetReadDeadline(time.Now().Add(t.readTimeout))
+	n, err := t.conn.Read(b)
+	if err != nil {
+		if isTimeout(err) {
+			return n, nil
+		}
+		t.drop()
+		if err == io.EOF {
+			// Plain io.EOF reads as a serial timeout to the frame parser.
+			err = ErrConnectionClosed
+		}
+	}
+	return n, err
+}
+
+func (t *tcpTransport) Write(b []byte) (int, error) {
+	if t.conn == nil {
+		if err := t.connect(); err != nil {
+			return 0, err
+		}
+	}
+	t.conn.SetWriteDeadline(time.Now().Add(t.timeout))
+	n, err := t.conn.Write(b)
+	if err != nil && n == 0 {
+		// The peer may have closed an idle connection; redial once.
+		if err = t.connect(); err != nil {
+			return 0, err
+		}
+		t.conn.SetWriteDeadline(time.Now().Add(t.timeout))
+		n, err = t.conn.Write(b)
+	}
+	if err != nil {
+		t.drop()
+	}
+	return n, err
+}
+
+func (t *tcpTransport) Flush() error {
+	if t.conn == nil {
+		return nil
+	}
+	buffer := make([]byte, 256)
+	for i := 0; i < 64; i++ {
+		t.conn.SetReadDeadline(time.Now().Add(tcpFlushTimeout))
+		n, err := t.conn.Read(buffer)
+		if err != nil {
+			if isTimeout(err) {
+				return nil
+			}
+			t.drop()
+			if err == io.EOF {
+				return ErrConnectionClosed
+			}
+			return err
+		}
+		if n == 0 {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (t *tcpTransport) Close() error {
+	if t.conn == nil {
+		return nil
+	}
+	err := t.conn.Close()
+	t.conn = nil
+	return err
+}
+
+func isTimeout(err error) bool {
+	netErr, ok := err.(net.Error)
+	return ok && netErr.Timeout()
+}
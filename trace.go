@@ -0,0 +1,36 @@
+package projector
+
+import (
+	"fmt"
+	"time"
+)
+
+// Logger receives protocol traces. *log.Logger satisfies it.
+type Logger interface {
+	Printf(format string, v ...any)
+}
+
+// WithLogger traces every frame sent and received, as a timestamped hex
+// dump followed by its decoded form, along with read errors.
+func WithLogger(logger Logger) Option {
+	return func(p *Projector) {
+		p.logger = logger
+	}
+}
+
+// trace logs one frame. dir is ">" for sent and "<" for received.
+func (p *Conn) trace(dir string, raw []byte, packet *Packet, err error) {
+	if p.logger == nil {
+		return
+	}
+	stamp := time.Now().Format("15:04:05.000000")
+	if err != nil {
+		p.logger.Printf("%s %s error: %v", stamp, dir, err)
+		return
+	}
+	p.logger.Printf("%s %s % x  %s", stamp, dir, raw, describe(packet))
+}
+
+func describe(packet *Packet) string {
+	return fmt.Sprintf("%s id=%d data=[% x]", packet.Command, packet.ID, packet.Data)
+}
@@ -0,0 +1,39 @@
+package projector
+
+import (
+	"io"
+	"strings"
+)
+
+// Transport is the byte stream a Projector speaks the RS-232 protocol over.
+// Read should return (0, nil) when no data arrives within the transport's
+// read timeout, matching the behaviour of a serial port. Flush discards any
+// pending input.
+type Transport interface {
+	io.ReadWriteCloser
+	Flush() error
+}
+
+// OpenTransport opens a port the way Projector.Open does but returns the
+// bare Transport, e.g. to serve it with a Bridge.
+func OpenTransport(portName string, opts ...Option) (Transport, error) {
+	p := &Projector{}
+	p.apply(opts)
+	return openPort(portName, p.SerialConfig(), p.backend)
+}
+
+// openPort opens a local serial device, or a remote one when the name is
+// "tcp://host:port" (raw ser2net) or "rfc2217://host:port" (telnet COM port
+// control, with the serial parameters applied remotely).
+func openPort(name string, config SerialConfig, backend SerialBackend) (Transport, error) {
+	switch {
+	case strings.HasPrefix(name, "tcp://"):
+		return dialTCPTransport(strings.TrimPrefix(name, "tcp://"), DefaultConnectTimeout, config.ReadTimeout)
+	case strings.HasPrefix(name, "rfc2217://"):
+		return dialRFC2217(strings.TrimPrefix(name, "rfc2217://"), config)
+	}
+	if backend == nil {
+		backend = TarmBackend
+	}
+	return backend.OpenSerial(NormalizePortName(name), config)
+}
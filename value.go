@@ -0,0 +1,96 @@
+package projector
+
+// Values travel little-endian. A response carries them after two status
+// bytes (see Packet.Value); a write carries them straight after the opcode
+// (see Command.Encode).
+
+// Value returns the value bytes of a response, after the two status bytes.
+func (p *Packet) Value() []byte {
+	if len(p.Data) < 2 {
+		return nil
+	}
+	return p.Data[2:]
+}
+
+func DecodeBool(b []byte) (bool, error) {
+	v, err := DecodeUint8(b)
+	return v > 0, err
+}
+
+func EncodeBool(v bool) []byte {
+	if v {
+		return []byte{1}
+	}
+	return []byte{0}
+}
+
+func DecodeUint8(b []byte) (uint8, error) {
+	if len(b) < 1 {
+		return 0, ErrShortValue
+	}
+	return b[0], nil
+}
+
+func EncodeUint8(v uint8) []byte {
+	return []byte{v}
+}
+
+func DecodeInt8(b []byte) (int8, error) {
+	v, err := DecodeUint8(b)
+	return int8(v), err
+}
+
+func EncodeInt8(v int8) []byte {
+	return []byte{byte(v)}
+}
+
+func DecodeUint16(b []byte) (uint16, error) {
+	if len(b) < 2 {
+		return 0, ErrShortValue
+	}
+	return uint16(b[0]) | uint16(b[1])<<8, nil
+}
+
+func EncodeUint16(v uint16) []byte {
+	return []byte{byte(v), byte(v >> 8)}
+}
+
+func DecodeInt16(b []byte) (int16, error) {
+	v, err := DecodeUint16(b)
+	return int16(v), err
+}
+
+func EncodeInt16(v int16) []byte {
+	return EncodeUint16(uint16(v))
+}
+
+func DecodeUint32(b []byte) (uint32, error) {
+	if len(b) < 4 {
+		return 0, ErrShortValue
+	}
+	return uint32(b[0]) | uint32(b[1])<<8 | uint32(b[2])<<16 | uint32(b[3])<<24, nil
+}
+
+func EncodeUint32(v uint32) []byte {
+	return []byte{byte(v), byte(v >> 8), byte(v >> 16), byte(v >> 24)}
+}
+
+// DecodePercent reads a one-byte value on a 0..max scale as a percentage,
+// rounded to the nearest whole percent.
+func DecodePercent(b []byte, max uint8) (int, error) {
+	v, err := DecodeUint8(b)
+	if err != nil || max == 0 {
+		return 0, err
+	}
+	return (int(v)*100 + int(max)/2) / int(max), nil
+}
+
+// EncodePercent scales percent, clamped to 0..100, onto a 0..max value.
+func EncodePercent(percent int, max uint8) []byte {
+	if percent < 0 {
+		percent = 0
+	} else if percent > 100 {
+		percent = 100
+	}
+	return []byte{byte((percent*int(max) + 50) / 100)}
+}
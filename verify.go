@@ -0,0 +1,78 @@
+package projector
+
+import (
+	"context"
+	"fmt"
+)
+
+// ErrVerifyFailed is returned, wrapped in a *VerifyError, when a write's
+// read-back shows the projector did not apply it.
+const ErrVerifyFailed = ProjectorError("Write not applied")
+
+type VerifyError struct {
+	Command  string
+	Expected int
+	Actual   int
+}
+
+func (e *VerifyError) Error() string {
+	return fmt.Sprintf("Write not applied: %s reads %d, expected %d", e.Command, e.Actual, e.Expected)
+}
+
+func (e *VerifyError) Is(target error) bool {
+	return target == ErrVerifyFailed
+}
+
+// WithVerifyWrites makes every setter read its value back and fail with
+// ErrVerifyFailed if the projector did not apply it. Commands with no way
+// to read back their effect are not checked.
+func WithVerifyWrites() Option {
+	return func(p *Projector) {
+		p.verifyWrites = true
+	}
+}
+
+func (p *Projector) verifying() bool {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return p.verifyWrites
+}
+
+// verify reads back the effect of writing value to c.
+func (p *Projector) verify(ctx context.Context, c Command, value int) error {
+	name, expected, ok := readBack(c, value)
+	if !ok {
+		return nil
+	}
+	actual, err := p.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	return checkVerify(name, expected, actual)
+}
+
+// readBack returns the command that reads back the effect of writing value
+// to c and what it should read. ok is false if nothing can.
+func readBack(c Command, value int) (name string, expected int, ok bool) {
+	name, expected = c.Name, value
+	if c.Access&ACCESS_READ == 0 {
+		if c.Verify == "" {
+			return "", 0, false
+		}
+		name = c.Verify
+		if c.Type == VALUE_NONE {
+			expected = c.VerifyValue
+		}
+	}
+	if c.Type == VALUE_BOOL {
+		expected = boolValue(expected != 0)
+	}
+	return name, expected, true
+}
+
+func checkVerify(name string, expected, actual int) error {
+	if actual != expected {
+		return &VerifyError{Command: name, Expected: expected, Actual: actual}
+	}
+	return nil
+}
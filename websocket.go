@@ -0,0 +1,145 @@
+package projector
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sync"
+
+	"github.com/gorilla/websocket"
+)
+
+// The bridge protocol is one binary message per chunk of serial data in
+// either direction. The client sends the text message "flush" to have the
+// bridge discard pending serial input.
+const bridgeFlush = "flush"
+
+type wsTransport struct {
+	conn   *websocket.Conn
+	reader *asyncReader
+}
+
+// DialWebSocket connects to a Bridge at url ("ws://host/path" or
+// "wss://host/path") and drives the projector on the far side of it.
+func DialWebSocket(ctx context.Context, url string, opts ...Option) (*Projector, error) {
+	p := &Projector{}
+	p.apply(opts)
+	config := p.SerialConfig()
+	dialCtx := ctx
+	err := p.attach(func() (Transport, error) {
+		// ctx only bounds the first dial; reconnects use their own timeout.
+		c := dialCtx
+		dialCtx = context.Background()
+		return dialWebSocket(c, url, config)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func dialWebSocket(ctx context.Context, url string, config SerialConfig) (*wsTransport, error) {
+	dialer := *websocket.DefaultDialer
+	dialer.HandshakeTimeout = DefaultConnectTimeout
+	conn, _, err := dialer.DialContext(ctx, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	t := &wsTransport{conn: conn}
+	t.reader = newAsyncReader(func() ([]byte, error) {
+		_, data, err := conn.ReadMessage()
+		return data, err
+	}, config.ReadTimeout)
+	return t, nil
+}
+
+func (t *wsTransport) Read(b []byte) (int, error) {
+	return t.reader.Read(b)
+}
+
+func (t *wsTransport) Write(b []byte) (int, error) {
+	if err := t.conn.WriteMessage(websocket.BinaryMessage, b); err != nil {
+		return 0, err
+	}
+	return len(b), nil
+}
+
+func (t *wsTransport) Buffered() int {
+	return t.reader.Buffered()
+}
+
+func (t *wsTransport) Flush() error {
+	t.reader.flush()
+	return t.conn.WriteMessage(websocket.TextMessage, []byte(bridgeFlush))
+}
+
+func (t *wsTransport) Close() error {
+	t.reader.close()
+	return t.conn.Close()
+}
+
+// Bridge is an http.Handler that exposes a local Transport, typically a
+// serial port from OpenTransport, to one DialWebSocket client at a time.
+type Bridge struct {
+	Port     Transport
+	Upgrader websocket.Upgrader
+
+	mu sync.Mutex
+}
+
+func NewBridge(port Transport) *Bridge {
+	return &Bridge{Port: port}
+}
+
+func (b *Bridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !b.mu.TryLock() {
+		http.Error(w, "bridge in use", http.StatusServiceUnavailable)
+		return
+	}
+	defer b.mu.Unlock()
+
+	conn, err := b.Upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for {
+			messageType, data, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			if messageType == websocket.TextMessage {
+				if string(data) == bridgeFlush {
+					b.Port.Flush()
+				}
+				continue
+			}
+			if _, err := b.Port.Write(data); err != nil {
+				return
+			}
+		}
+	}()
+
+	buffer := make([]byte, 256)
+	for {
+		select {
+		case <-done:
+			return
+		default:
+		}
+		n, err := b.Port.Read(buffer)
+		if err != nil && err != io.EOF {
+			return
+		}
+		if n == 0 {
+			continue
+		}
+		if err := conn.WriteMessage(websocket.BinaryMessage, buffer[:n]); err != nil {
+			return
+		}
+	}
+}